@@ -0,0 +1,195 @@
+package competition
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// calendarTime is how times are written in iCalendar files, always in UTC
+const calendarTime = "20060102T150405Z"
+
+// calendarGame is a scheduled game along with where it is in its competition
+type calendarGame struct {
+	game        models.Game
+	competition string
+	tournament  string
+	round       int // Starting at 1
+	number      int // Number of the game within its round, starting at 1
+}
+
+// uid identifies the event for a game. It comes from the game's stored id, so it stays the same as the game is rescheduled, its result changes
+// or earlier rounds are removed, and calendars that are subscribed to update the event rather than adding another one
+func (g calendarGame) uid() string {
+	return fmt.Sprintf("game-%d@competition", g.game.GetId())
+}
+
+// CompetitionCalendar writes every scheduled game of a competition as an iCalendar (RFC 5545) file
+func CompetitionCalendar(c models.Competition) ([]byte, error) {
+	return writeCalendar(c.GetName(), scheduledGames(c, func(models.Game) bool { return true }), nil), nil
+}
+
+// TeamCalendar writes the scheduled games of a team in a competition as an iCalendar file
+func TeamCalendar(c models.Competition, t models.Team) ([]byte, error) {
+	games := scheduledGames(c, func(g models.Game) bool {
+		for _, other := range g.GetTeams() {
+			if !models.IsByeTeam(other) && other.Equals(t) {
+				return true
+			}
+		}
+		return false
+	})
+	return writeCalendar(t.GetName()+" - "+c.GetName(), games, func(team models.Team) bool { return team.Equals(t) }), nil
+}
+
+// PlayerCalendar writes the scheduled games of every team a player is on in a competition as an iCalendar file
+func PlayerCalendar(c models.Competition, p models.Player) ([]byte, error) {
+	onTeam := func(team models.Team) bool {
+		if models.IsByeTeam(team) {
+			return false
+		}
+		for _, member := range team.GetPlayers() {
+			if member.GetName() == p.GetName() {
+				return true
+			}
+		}
+		return false
+	}
+	games := scheduledGames(c, func(g models.Game) bool {
+		for _, t := range g.GetTeams() {
+			if onTeam(t) {
+				return true
+			}
+		}
+		return false
+	})
+	return writeCalendar(p.GetName()+" - "+c.GetName(), games, onTeam), nil
+}
+
+// ArenaCalendar writes the games scheduled at an arena in a competition as an iCalendar file
+func ArenaCalendar(c models.Competition, a models.Arena) ([]byte, error) {
+	games := scheduledGames(c, func(g models.Game) bool { return g.GetArena().GetName() == a.GetName() })
+	return writeCalendar(a.GetName()+" - "+c.GetName(), games, nil), nil
+}
+
+// scheduledGames returns the games of a competition that have been scheduled and are wanted, in the order they are played
+func scheduledGames(c models.Competition, wanted func(models.Game) bool) []calendarGame {
+	var games []calendarGame
+	for _, t := range c.GetAllTournaments() {
+		for r, round := range t.GetAllRounds() {
+			for n, g := range round.GetGames() {
+				if start, _ := g.GetSchedule(); start.IsZero() || !wanted(g) {
+					continue
+				}
+				games = append(games, calendarGame{game: g, competition: c.GetName(), tournament: t.GetName(), round: r + 1, number: n + 1})
+			}
+		}
+	}
+	sort.SliceStable(games, func(i, j int) bool {
+		a, _ := games[i].game.GetSchedule()
+		b, _ := games[j].game.GetSchedule()
+		return a.Before(b)
+	})
+	return games
+}
+
+// writeCalendar writes games as the events of a calendar. When own is set, events are named by the opponents of the teams it picks out
+func writeCalendar(name string, games []calendarGame, own func(models.Team) bool) []byte {
+	var buf bytes.Buffer
+	line := func(content string) {
+		writeCalendarLine(&buf, content)
+	}
+	stamp := time.Now().UTC().Format(calendarTime)
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//justinjudd//competition//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + escapeCalendarText(name))
+	for _, cg := range games {
+		g := cg.game
+		start, end := g.GetSchedule()
+
+		var teams, opponents []string
+		for _, t := range g.GetTeams() {
+			if models.IsByeTeam(t) {
+				continue
+			}
+			teams = append(teams, t.GetName())
+			if own != nil && !own(t) {
+				opponents = append(opponents, t.GetName())
+			}
+		}
+		summary := strings.Join(teams, " vs ")
+		if own != nil && len(opponents) > 0 && len(opponents) < len(teams) {
+			summary = "vs " + strings.Join(opponents, ", ")
+		}
+
+		description := []string{cg.tournament, fmt.Sprintf("Round %d, game %d", cg.round, cg.number)}
+		if b := g.GetBracket(); b != "" {
+			description = append(description, "Bracket: "+b)
+		}
+		description = append(description, strings.Join(teams, " vs "))
+		if g.GetStatus() == models.Status_COMPLETED {
+			description = append(description, "Final: "+calendarResult(g))
+		}
+
+		line("BEGIN:VEVENT")
+		line("UID:" + cg.uid())
+		line("DTSTAMP:" + stamp)
+		line("DTSTART:" + start.UTC().Format(calendarTime))
+		line("DTEND:" + end.UTC().Format(calendarTime))
+		line("SUMMARY:" + escapeCalendarText(summary+" ("+cg.tournament+")"))
+		if arena := g.GetArena().GetName(); arena != "" {
+			line("LOCATION:" + escapeCalendarText(arena))
+		}
+		line("DESCRIPTION:" + escapeCalendarText(strings.Join(description, "\n")))
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// calendarResult describes how the teams of a finished game did, by score if the game is scored or else by place
+func calendarResult(g models.Game) string {
+	scores := g.GetScores()
+	places := g.GetPlaces()
+	var parts []string
+	for i, t := range g.GetTeams() {
+		if models.IsByeTeam(t) {
+			continue
+		}
+		switch {
+		case g.IsScored() && i < len(scores):
+			parts = append(parts, fmt.Sprintf("%s %d", t.GetName(), scores[i]))
+		case i < len(places):
+			parts = append(parts, fmt.Sprintf("%s placed %d", t.GetName(), FlipTies(int(places[i]))+1))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// escapeCalendarText escapes the characters with special meaning in iCalendar text values
+func escapeCalendarText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// writeCalendarLine writes a content line, folding it so no line is longer than 75 octets without splitting a character
+func writeCalendarLine(buf *bytes.Buffer, content string) {
+	limit := 75
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && content[cut]&0xC0 == 0x80 { // Don't cut through a multi-byte character
+			cut--
+		}
+		buf.WriteString(content[:cut] + "\r\n ")
+		content = content[cut:]
+		limit = 74 // Continuation lines start with a space
+	}
+	buf.WriteString(content + "\r\n")
+}
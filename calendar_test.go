@@ -0,0 +1,71 @@
+package competition
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// calendarUIDs returns the UIDs of the events in a calendar, in order
+func calendarUIDs(t *testing.T, c models.Competition) []string {
+	data, err := CompetitionCalendar(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var uids []string
+	for _, match := range regexp.MustCompile(`UID:(\S+)`).FindAllStringSubmatch(string(data), -1) {
+		uids = append(uids, match[1])
+	}
+	return uids
+}
+
+func TestCalendarUIDFollowsGame(t *testing.T) {
+	e := testutil.NewEngine(t)
+	c := e.CreateCompetition("League", nil)
+	arena := c.CreateArena("Court 1")
+	base := c.AddTournament("Cup", models.TournamentType_SINGLE_ELIMINATION, nil, false, 2, 1, false)
+	for _, name := range []string{"Ants", "Bees", "Cats", "Dogs"} {
+		base.CreateTeam(name, []models.Player{e.CreatePlayer(name, nil)}, nil)
+	}
+	cup, err := tournament.Wrap(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	first, err := cup.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, game := range first.GetGames() {
+		game.SetPlaces([]int64{0, 1})
+		game.SetFinal()
+	}
+	first.SetFinal()
+	final, err := cup.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	final.GetGames()[0].Schedule(arena, start, start.Add(time.Hour))
+	before := calendarUIDs(t, c)
+
+	// Rescheduling keeps the event
+	final.GetGames()[0].Schedule(arena, start.Add(time.Hour), start.Add(2*time.Hour))
+	if after := calendarUIDs(t, c); len(after) != 1 || after[0] != before[0] {
+		t.Errorf("Rescheduled game has UIDs %v, expected %v", after, before)
+	}
+
+	// A final made again from a corrected result is a different game, even though it's in the same place
+	final, err = tournament.CorrectGame(cup, first.GetGames()[0], "Ref", nil, []int64{1, 0}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	final.GetGames()[0].Schedule(arena, start, start.Add(time.Hour))
+	if after := calendarUIDs(t, c); len(after) != 1 || after[0] == before[0] {
+		t.Errorf("New final has UIDs %v, the old final had %v", after, before)
+	}
+}
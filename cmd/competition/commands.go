@@ -0,0 +1,848 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justinjudd/competition"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/officials"
+	"github.com/justinjudd/competition/schedule"
+	"github.com/justinjudd/competition/tournament"
+)
+
+var tournamentTypes = map[string]models.TournamentType{
+	"single":  models.TournamentType_SINGLE_ELIMINATION,
+	"double":  models.TournamentType_DOUBLE_ELIMINATION,
+	"robin":   models.TournamentType_ROUND_ROBIN,
+	"compass": models.TournamentType_COMPASS_DRAW,
+	"swiss":   models.TournamentType_SWISS_FORMAT,
+	"groups":  models.TournamentType_GROUP_PLAY,
+}
+
+func tournamentTypeList() string {
+	names := make([]string, 0, len(tournamentTypes))
+	for name := range tournamentTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// typeName is the name a tournament type is given on the command line
+func typeName(t models.TournamentType) string {
+	for name, other := range tournamentTypes {
+		if other == t {
+			return name
+		}
+	}
+	return fmt.Sprint(t)
+}
+
+var statusNames = map[models.Status]string{
+	models.Status_NEW:       "new",
+	models.Status_ONGOING:   "ongoing",
+	models.Status_COMPLETED: "completed",
+}
+
+func create(engine models.StorageEngine, args []string) error {
+	if len(args) != 1 {
+		return usageError("create")
+	}
+	if _, err := findCompetition(engine, args[0]); err == nil {
+		return fmt.Errorf("There is already a competition named %q", args[0])
+	}
+	engine.CreateCompetition(args[0], nil)
+	return nil
+}
+
+func list(engine models.StorageEngine, args []string) error {
+	switch len(args) {
+	case 0:
+		for _, c := range engine.GetCompetitions() {
+			fmt.Println(c.GetName())
+		}
+	case 1:
+		c, err := findCompetition(engine, args[0])
+		if err != nil {
+			return err
+		}
+		for _, t := range c.GetAllTournaments() {
+			fmt.Printf("%s\t%d teams\t%d rounds\t%s\n", t.GetName(), len(t.GetTeams()), len(t.GetAllRounds()), statusNames[t.GetStatus()])
+		}
+	default:
+		return usageError("list")
+	}
+	return nil
+}
+
+func add(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("add", flag.ContinueOnError)
+	typeName := flags.String("type", "single", "Type of tournament: "+tournamentTypeList())
+	seeded := flags.Bool("seeded", false, "Seed teams in the order they were added")
+	scored := flags.Bool("scored", false, "Games are decided by score")
+	size := flags.Uint("size", 2, "Number of teams in each game")
+	advance := flags.Uint("advance", 1, "Number of teams advancing from each game")
+	rounds := flags.Uint("rounds", 0, "Number of rounds to play in a Swiss tournament, instead of enough to separate the teams")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError("add")
+	}
+	tournamentType, ok := tournamentTypes[*typeName]
+	if !ok {
+		return fmt.Errorf("Unknown tournament type %q, expected one of %s", *typeName, tournamentTypeList())
+	}
+
+	c, err := findCompetition(engine, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if _, err := findTournament(engine, flags.Arg(0), flags.Arg(1)); err == nil {
+		return fmt.Errorf("There is already a tournament named %q", flags.Arg(1))
+	}
+	t := c.AddTournament(flags.Arg(1), tournamentType, nil, *seeded, uint32(*size), uint32(*advance), *scored)
+	if *rounds > 0 {
+		t.SetRoundCount(uint32(*rounds))
+	}
+	return nil
+}
+
+func importTeams(engine models.StorageEngine, args []string) error {
+	if len(args) != 3 {
+		return usageError("import")
+	}
+	t, err := findTournament(engine, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(args[2])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	teams, err := competition.ImportTeams(engine, t, f)
+	if err != nil {
+		return fmt.Errorf("Unable to import teams:\n%w", err)
+	}
+	for _, team := range teams {
+		fmt.Printf("Added %s with %d players\n", team.GetName(), len(team.GetPlayers()))
+	}
+	return nil
+}
+
+func importPlayers(engine models.StorageEngine, args []string) error {
+	if len(args) != 1 {
+		return usageError("players")
+	}
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	players, err := competition.ImportPlayers(engine, f)
+	if err != nil {
+		return fmt.Errorf("Unable to import players:\n%w", err)
+	}
+	fmt.Printf("Added %d players\n", len(players))
+	return nil
+}
+
+func advance(engine models.StorageEngine, args []string) error {
+	if len(args) != 2 {
+		return usageError("advance")
+	}
+	t, err := findTournament(engine, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if t.GetStatus() == models.Status_COMPLETED {
+		return fmt.Errorf("%s is already over", t.GetName())
+	}
+
+	rounds := t.GetAllRounds()
+	if len(rounds) > 0 {
+		current := rounds[len(rounds)-1]
+		if current.GetStatus() != models.Status_COMPLETED {
+			for i, g := range current.GetGames() {
+				if g.GetStatus() != models.Status_COMPLETED {
+					return fmt.Errorf("Game %d of round %d isn't final yet", i+1, len(rounds))
+				}
+			}
+			current.SetFinal()
+		}
+	}
+
+	// Formats finish the tournament when there is no round left to create, and report that as an error too
+	round, err := t.NextRound()
+	if t.GetStatus() == models.Status_COMPLETED {
+		fmt.Printf("%s is over\n", t.GetName())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Round %d\n", len(t.GetAllRounds()))
+	for i, g := range round.GetGames() {
+		fmt.Printf("  %d. %s\n", i+1, describeGame(g, t.IsScored()))
+	}
+	return nil
+}
+
+func result(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("result", flag.ContinueOnError)
+	usePlaces := flags.Bool("places", false, "Values are the places of the teams, 0 for first")
+	pending := flags.Bool("pending", false, "Leave the game open, as the result isn't final yet")
+	official := flags.String("official", "", "Official correcting the result of a game that is already final")
+	replay := flags.Bool("replay", false, "Let a correction remove later rounds that have already been played, so they are made again from the corrected result")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() < 5 {
+		return usageError("result")
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	roundNumber, err := strconv.Atoi(flags.Arg(2))
+	rounds := t.GetAllRounds()
+	if err != nil || roundNumber < 1 || roundNumber > len(rounds) {
+		return fmt.Errorf("No round %s", flags.Arg(2))
+	}
+	gameNumber, err := strconv.Atoi(flags.Arg(3))
+	games := rounds[roundNumber-1].GetGames()
+	if err != nil || gameNumber < 1 || gameNumber > len(games) {
+		return fmt.Errorf("No game %s in round %d", flags.Arg(3), roundNumber)
+	}
+	game := games[gameNumber-1]
+
+	values := []int64{}
+	for _, arg := range flags.Args()[4:] {
+		v, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Invalid value %q", arg)
+		}
+		values = append(values, v)
+	}
+	if len(values) != len(game.GetTeams()) {
+		return fmt.Errorf("Expected %d values, one for each team, got %d", len(game.GetTeams()), len(values))
+	}
+	var scores, places []int64
+	if *usePlaces || !t.IsScored() {
+		places = values
+	} else {
+		scores = values
+	}
+
+	if game.GetStatus() == models.Status_COMPLETED {
+		if *official == "" {
+			return fmt.Errorf("Game is already final, pass -official to correct it")
+		}
+		regenerated, err := tournament.CorrectGame(t, game, *official, scores, places, *replay)
+		if err != nil {
+			return err
+		}
+		if regenerated != nil {
+			fmt.Printf("Later rounds were removed, round %d was created again\n", len(t.GetAllRounds()))
+		}
+	} else {
+		if scores != nil {
+			game.SetScores(scores)
+		}
+		if places != nil {
+			game.SetPlaces(places)
+		}
+		if !*pending {
+			game.SetFinal()
+		}
+	}
+
+	game = t.GetAllRounds()[roundNumber-1].GetGames()[gameNumber-1]
+	fmt.Println(describeGame(game, t.IsScored()))
+	return nil
+}
+
+// timeLayout is how times are given to and shown by the schedule command
+const timeLayout = "2006-01-02 15:04"
+
+func scheduleRound(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("schedule", flag.ContinueOnError)
+	startText := flags.String("start", "", "When the first game can start, as "+timeLayout)
+	duration := flags.Duration("duration", time.Hour, "How long each game is booked for")
+	rest := flags.Duration("rest", 0, "Least time a team or player has between games")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() < 3 || *startText == "" {
+		return usageError("schedule")
+	}
+	start, err := time.ParseInLocation(timeLayout, *startText, time.Local)
+	if err != nil {
+		return fmt.Errorf("Invalid start time %q, expected %s", *startText, timeLayout)
+	}
+	c, err := findCompetition(engine, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	round := t.GetActiveRound()
+	if round == nil {
+		return fmt.Errorf("%s has no round to schedule", t.GetName())
+	}
+
+	opts := schedule.Options{Start: start, Duration: *duration, Rest: *rest, Competition: c}
+	for _, arg := range flags.Args()[2:] {
+		name, hours := arg, ""
+		if i := strings.LastIndex(arg, "="); i >= 0 {
+			name, hours = arg[:i], arg[i+1:]
+		}
+		window := schedule.Window{Start: start, End: start.Add(24 * time.Hour)}
+		if hours != "" {
+			window, err = parseHours(start, hours)
+			if err != nil {
+				return err
+			}
+		}
+		opts.Arenas = append(opts.Arenas, schedule.Arena{Arena: findArena(c, name), Windows: []schedule.Window{window}})
+	}
+
+	bookings, err := schedule.Round(round, opts)
+	if err != nil {
+		return err
+	}
+	for _, b := range bookings {
+		fmt.Printf("  %s\n", describeGame(b.Game, t.IsScored()))
+	}
+	return nil
+}
+
+func arenas(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("arenas", flag.ContinueOnError)
+	assign := flags.Bool("assign", false, "Queue games that have no arena before listing")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return usageError("arenas")
+	}
+	c, err := findCompetition(engine, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if *assign {
+		if err := c.AssignArenas(); err != nil {
+			return err
+		}
+	}
+	for _, a := range c.GetArenas() {
+		fmt.Println(a.GetName())
+		for i, g := range a.GetGames() {
+			fmt.Printf("  %d. %s\n", i+1, describeGame(g, g.IsScored()))
+		}
+		if next := a.NextGame(); next != nil && next.GetStatus() == models.Status_NEW {
+			fmt.Printf("  Next up: %s\n", describeGame(next, next.IsScored()))
+		}
+	}
+	return nil
+}
+
+func addOfficial(engine models.StorageEngine, args []string) error {
+	if len(args) < 2 || len(args) > 3 {
+		return usageError("official")
+	}
+	c, err := findCompetition(engine, args[0])
+	if err != nil {
+		return err
+	}
+	var p models.Player
+	if len(args) == 3 {
+		p = findPlayer(engine, args[2])
+	}
+	o := c.CreateOfficial(args[1], p)
+	fmt.Printf("Added official %s\n", o.GetName())
+	return nil
+}
+
+func officiate(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("officiate", flag.ContinueOnError)
+	roles := flags.String("roles", "Referee", "Comma separated roles each game needs")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError("officiate")
+	}
+	c, err := findCompetition(engine, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	round := t.GetActiveRound()
+	if round == nil {
+		return fmt.Errorf("%s has no round to officiate", t.GetName())
+	}
+
+	opts := officials.Options{}
+	for _, role := range strings.Split(*roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			opts.Roles = append(opts.Roles, role)
+		}
+	}
+	for _, o := range c.GetOfficials() {
+		if o.GetTeam() == nil { // Teams only officiate as losers ref
+			opts.Officials = append(opts.Officials, officials.Official{Official: o})
+		}
+	}
+	assignments, err := officials.Round(round, opts)
+	if err != nil {
+		return err
+	}
+	for _, a := range assignments {
+		fmt.Printf("  %s: %s for %s\n", a.Official.GetName(), a.Role, describeGame(a.Game, t.IsScored()))
+	}
+	return nil
+}
+
+func losersRef(engine models.StorageEngine, args []string) error {
+	if len(args) < 1 || len(args) > 2 {
+		return usageError("losers-ref")
+	}
+	c, err := findCompetition(engine, args[0])
+	if err != nil {
+		return err
+	}
+	role := ""
+	if len(args) == 2 {
+		role = args[1]
+	}
+	c.SetLosersRef(role)
+	return nil
+}
+
+func playerStats(engine models.StorageEngine, args []string) error {
+	if len(args) != 1 {
+		return usageError("stats")
+	}
+	var p models.Player
+	for _, other := range engine.GetPlayers() {
+		if other.GetName() == args[0] {
+			p = other
+		}
+	}
+	if p == nil {
+		return fmt.Errorf("No player named %q", args[0])
+	}
+
+	stats := p.GetStats()
+	printStats("Career", stats.Total)
+	printBreakdown("Competitions", stats.ByCompetition)
+	types := map[string]models.Stats{}
+	for t, s := range stats.ByTournamentType {
+		types[typeName(t)] = s
+	}
+	printBreakdown("Tournament types", types)
+	printBreakdown("Teammates", stats.ByTeammate)
+	return nil
+}
+
+func versus(engine models.StorageEngine, args []string) error {
+	var m models.Matchup
+	switch len(args) {
+	case 2:
+		var players [2]models.Player
+		for i, name := range args {
+			for _, p := range engine.GetPlayers() {
+				if p.GetName() == name {
+					players[i] = p
+				}
+			}
+			if players[i] == nil {
+				return fmt.Errorf("No player named %q", name)
+			}
+		}
+		m = models.PlayerHeadToHead(players[0], players[1])
+	case 4:
+		t, err := findTournament(engine, args[0], args[1])
+		if err != nil {
+			return err
+		}
+		var teams [2]models.Team
+		for i, name := range args[2:] {
+			for _, team := range t.GetTeams() {
+				if team.GetName() == name {
+					teams[i] = team
+				}
+			}
+			if teams[i] == nil {
+				return fmt.Errorf("No team named %q in %s", name, args[1])
+			}
+		}
+		m = models.HeadToHead(teams[0], teams[1])
+	default:
+		return usageError("versus")
+	}
+
+	for _, g := range m.Games {
+		fmt.Println(describeGame(g, g.IsScored()))
+	}
+	if len(m.Games) > 0 {
+		fmt.Println()
+	}
+	fmt.Printf("%s: %d played, %d-%d-%d", args[len(args)-2], m.Played, m.Wins, m.Losses, m.Draws)
+	if m.PointsFor != 0 || m.PointsAgainst != 0 {
+		fmt.Printf(", points %d-%d", m.PointsFor, m.PointsAgainst)
+	}
+	fmt.Println()
+	return nil
+}
+
+// printBreakdown prints stats under a heading, sorted by name
+func printBreakdown(heading string, stats map[string]models.Stats) {
+	if len(stats) == 0 {
+		return
+	}
+	fmt.Println()
+	fmt.Println(heading)
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		printStats("  "+name, stats[name])
+	}
+}
+
+func printStats(name string, s models.Stats) {
+	fmt.Printf("%s: %d played, %d-%d-%d (%.0f%%), points %d-%d, %d titles", name, s.Played, s.Wins, s.Losses, s.Draws, s.WinRate()*100, s.PointsFor, s.PointsAgainst, s.Titles)
+	if s.BestFinish > 0 {
+		fmt.Printf(", best finish %d", s.BestFinish)
+	}
+	fmt.Println()
+}
+
+// parseHours reads opening hours such as 09:00-17:00 as a window on the day of start
+func parseHours(start time.Time, hours string) (schedule.Window, error) {
+	parts := strings.Split(hours, "-")
+	if len(parts) != 2 {
+		return schedule.Window{}, fmt.Errorf("Invalid hours %q, expected HH:MM-HH:MM", hours)
+	}
+	var times [2]time.Time
+	for i, part := range parts {
+		clock, err := time.Parse("15:04", part)
+		if err != nil {
+			return schedule.Window{}, fmt.Errorf("Invalid hours %q, expected HH:MM-HH:MM", hours)
+		}
+		times[i] = time.Date(start.Year(), start.Month(), start.Day(), clock.Hour(), clock.Minute(), 0, 0, start.Location())
+	}
+	return schedule.Window{Start: times[0], End: times[1]}, nil
+}
+
+func bracket(engine models.StorageEngine, args []string) error {
+	if len(args) != 2 {
+		return usageError("bracket")
+	}
+	t, err := findTournament(engine, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	text, err := competition.GenerateTournamentText(t)
+	if err != nil {
+		return fmt.Errorf("Unable to draw bracket: %w", err)
+	}
+	_, err = os.Stdout.Write(text)
+	return err
+}
+
+// describeGame is a line of text with the teams of a game and how they did
+func describeGame(g models.Game, scored bool) string {
+	teams := g.GetTeams()
+	scores := g.GetScores()
+	places := g.GetPlaces()
+	parts := make([]string, len(teams))
+	for i, team := range teams {
+		parts[i] = team.GetName()
+		if scored && i < len(scores) {
+			parts[i] += " " + strconv.FormatInt(scores[i], 10)
+		}
+		if g.GetStatus() == models.Status_COMPLETED && i < len(places) {
+			parts[i] += fmt.Sprintf(" (%s)", ordinal(places[i]+1))
+		}
+	}
+	line := strings.Join(parts, " vs ")
+	if len(teams) == 1 {
+		line += " (bye)"
+	}
+	if g.GetStatus() != models.Status_COMPLETED {
+		line += " - " + statusNames[g.GetStatus()]
+	}
+	if arena := g.GetArena().GetName(); arena != "" {
+		line += " @ " + arena
+	}
+	if start, _ := g.GetSchedule(); !start.IsZero() {
+		line += " " + start.Format(timeLayout)
+	}
+	return line
+}
+
+func ordinal(n int64) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.FormatInt(n, 10) + suffix
+}
+
+// pageHTML wraps the brackets of a tournament in a page, with enough styling to read them without outside CSS
+const pageHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+<style>
+body { font-family: sans-serif; }
+main.bracket { display: flex; flex-direction: row; }
+main.bracket ul { display: flex; flex-direction: column; justify-content: space-around; list-style: none; margin: 0 1em 0 0; padding: 0; min-width: 12em; }
+main.bracket li { padding: 0.2em 0.4em; }
+main.bracket li.game { border-left: 1px solid #999; }
+main.bracket li.game-top { border-top: 1px solid #999; }
+main.bracket li.game-bottom { border-bottom: 1px solid #999; }
+main.bracket li.winner { font-weight: bold; }
+main.bracket li img { height: 1em; margin-right: 0.3em; }
+main.bracket li span { float: right; margin-left: 0.4em; }
+</style>
+</head>
+<body>
+%s
+</body>
+</html>
+`
+
+func exportHTML(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("html", flag.ContinueOnError)
+	out := flags.String("o", "", "File to write the page to, instead of standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError("html")
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	brackets, err := competition.GenerateTournamentHTML(t)
+	if err != nil {
+		return fmt.Errorf("Unable to generate HTML: %w", err)
+	}
+	page := fmt.Sprintf(pageHTML, t.GetName(), brackets)
+	if *out == "" {
+		_, err = os.Stdout.WriteString(page)
+		return err
+	}
+	return ioutil.WriteFile(*out, []byte(page), 0644)
+}
+
+func exportSVG(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("svg", flag.ContinueOnError)
+	out := flags.String("o", "", "File to write the image to, instead of standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError("svg")
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	image, err := competition.GenerateTournamentSVG(t)
+	if err != nil {
+		return fmt.Errorf("Unable to draw bracket: %w", err)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(image)
+		return err
+	}
+	return ioutil.WriteFile(*out, image, 0644)
+}
+
+func exportJSON(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("json", flag.ContinueOnError)
+	out := flags.String("o", "", "File to write the bracket to, instead of standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError("json")
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	data, err := competition.ExportTournament(t)
+	if err != nil {
+		return fmt.Errorf("Unable to export bracket: %w", err)
+	}
+	data = append(data, '\n')
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return ioutil.WriteFile(*out, data, 0644)
+}
+
+func exportResults(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("results", flag.ContinueOnError)
+	out := flags.String("o", "", "File to write the results to, instead of standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return usageError("results")
+	}
+	t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+	if err != nil {
+		return err
+	}
+	data, err := competition.ExportResults(t)
+	if err != nil {
+		return fmt.Errorf("Unable to export results: %w", err)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return ioutil.WriteFile(*out, data, 0644)
+}
+
+// hostedCommand makes a command that exports a tournament to a file another system reads, like a bracket site's JSON, or imports one from it
+func hostedCommand(name string, export func(models.Tournament) ([]byte, error), load func(models.StorageEngine, models.Competition, []byte) (models.Tournament, error)) func(models.StorageEngine, []string) error {
+	return func(engine models.StorageEngine, args []string) error {
+		flags := flag.NewFlagSet(name, flag.ContinueOnError)
+		out := flags.String("o", "", "File to write the tournament to, instead of standard output")
+		in := flags.String("import", "", "File to import a tournament from, instead of exporting one")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+
+		if *in != "" {
+			if flags.NArg() != 1 {
+				return usageError(name)
+			}
+			c, err := findCompetition(engine, flags.Arg(0))
+			if err != nil {
+				return err
+			}
+			data, err := ioutil.ReadFile(*in)
+			if err != nil {
+				return err
+			}
+			t, err := load(engine, c, data)
+			if t != nil {
+				fmt.Printf("Added %s with %d rounds\n", t.GetName(), len(t.GetAllRounds()))
+			}
+			return err
+		}
+
+		if flags.NArg() != 2 {
+			return usageError(name)
+		}
+		t, err := findTournament(engine, flags.Arg(0), flags.Arg(1))
+		if err != nil {
+			return err
+		}
+		data, err := export(t)
+		if err != nil {
+			return fmt.Errorf("Unable to export tournament: %w", err)
+		}
+		data = append(data, '\n')
+		if *out == "" {
+			_, err = os.Stdout.Write(data)
+			return err
+		}
+		return ioutil.WriteFile(*out, data, 0644)
+	}
+}
+
+func exportCalendar(engine models.StorageEngine, args []string) error {
+	flags := flag.NewFlagSet("calendar", flag.ContinueOnError)
+	out := flags.String("o", "", "File to write the calendar to, instead of standard output")
+	tournamentName := flags.String("tournament", "", "Tournament the team is in")
+	teamName := flags.String("team", "", "Only the games of this team")
+	playerName := flags.String("player", "", "Only the games of the teams this player is on")
+	arenaName := flags.String("arena", "", "Only the games at this arena")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 || (*teamName != "") != (*tournamentName != "") {
+		return usageError("calendar")
+	}
+	c, err := findCompetition(engine, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	var cal []byte
+	switch {
+	case *teamName != "":
+		t, err := findTournament(engine, flags.Arg(0), *tournamentName)
+		if err != nil {
+			return err
+		}
+		team := t.GetTeam(*teamName)
+		if team == nil {
+			return fmt.Errorf("No team named %q in %s", *teamName, t.GetName())
+		}
+		cal, err = competition.TeamCalendar(c, team)
+	case *playerName != "":
+		p := engine.GetPlayer(*playerName)
+		if p == nil {
+			return fmt.Errorf("No player named %q", *playerName)
+		}
+		cal, err = competition.PlayerCalendar(c, p)
+	case *arenaName != "":
+		var arena models.Arena
+		for _, a := range c.GetArenas() {
+			if a.GetName() == *arenaName {
+				arena = a
+			}
+		}
+		if arena == nil {
+			return fmt.Errorf("No arena named %q", *arenaName)
+		}
+		cal, err = competition.ArenaCalendar(c, arena)
+	default:
+		cal, err = competition.CompetitionCalendar(c)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to export calendar: %w", err)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(cal)
+		return err
+	}
+	return ioutil.WriteFile(*out, cal, 0644)
+}
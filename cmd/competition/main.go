@@ -0,0 +1,164 @@
+// Command competition runs competitions from the terminal, storing them in a storm database.
+//
+// Usage:
+//
+//	competition [-db file] <command> [arguments]
+//
+// Run competition help for the list of commands
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/justinjudd/competition"
+	"github.com/justinjudd/competition/hosted"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// command is a subcommand of the tool
+type command struct {
+	usage string // Arguments, after the name of the command
+	help  string
+	run   func(engine models.StorageEngine, args []string) error
+}
+
+var commands map[string]command
+
+func init() {
+	// Set up in init, as commands report their usage from the table
+	commands = map[string]command{
+		"create":  {"<competition>", "Create a competition", create},
+		"list":    {"[competition]", "List the competitions, or the tournaments of a competition", list},
+		"add":     {"[-type TYPE] [-seeded] [-scored] [-size N] [-advance N] [-rounds N] <competition> <tournament>", "Add a tournament to a competition", add},
+		"import":  {"<competition> <tournament> <file.csv>", "Import teams into a tournament from CSV, one team a line: name,player,player... Nothing is imported if any line has a problem", importTeams},
+		"players": {"<file.csv>", "Import players from CSV, one player a line: name,metadata. Metadata is a reference such as the URL of a photo", importPlayers},
+		"advance": {"<competition> <tournament>", "Finish the current round once all its games are final, and create the next round", advance},
+		"result": {"[-places] [-pending] [-official NAME] [-replay] <competition> <tournament> <round> <game> <value>...",
+			"Enter the scores of a game, or its places with -places or when the tournament isn't scored. Games already final need an official, and are corrected. -replay lets the correction remove later rounds that were played", result},
+		"schedule": {"-start TIME [-duration D] [-rest D] <competition> <tournament> <arena>[=HH:MM-HH:MM]...",
+			"Book the games of the current round into arenas and time slots. Arenas are free all day unless given the hours they are open. Players on teams in other tournaments of the competition aren't booked twice at once", scheduleRound},
+		"arenas":     {"[-assign] <competition>", "List the games queued at each arena. With -assign, first queue games without an arena at the arena with the fewest games", arenas},
+		"official":   {"<competition> <name> [player]", "Add an official to a competition. Officials who also play aren't given games their teams play in", addOfficial},
+		"officiate":  {"[-roles LIST] <competition> <tournament>", "Give the games of the current round officials for each role, eg -roles Referee,Scorekeeper", officiate},
+		"losers-ref": {"<competition> [role]", "The losing team of each game at an arena takes role in the next game there. Leave out role to turn it off", losersRef},
+		"stats":      {"<player>", "Print the career record of a player across every competition, broken down by competition, tournament type and teammate", playerStats},
+		"versus":     {"<player> <player> | <competition> <tournament> <team> <team>", "Print how one player or team has done against another, and the games they played", versus},
+		"calendar": {"[-o file] [-tournament NAME -team NAME | -player NAME | -arena NAME] <competition>",
+			"Export the scheduled games of a competition, or of a team, player or arena in it, as an iCalendar file", exportCalendar},
+		"bracket": {"<competition> <tournament>", "Print the bracket of a tournament as text", bracket},
+		"html":    {"[-o file] <competition> <tournament>", "Export the bracket of a tournament as an HTML page", exportHTML},
+		"svg":     {"[-o file] <competition> <tournament>", "Export the bracket of a tournament as an SVG image", exportSVG},
+		"results": {"[-o file] <competition> <tournament>", "Export the results of a tournament as CSV, one line for each team of each game", exportResults},
+		"challonge": {"[-o file] <competition> <tournament> | -import file.json <competition>",
+			"Export an elimination tournament as a Challonge tournament, or import one into a competition, replaying the matches played so far", hostedCommand("challonge", hosted.ExportChallonge, hosted.ImportChallonge)},
+		"startgg": {"[-o file] <competition> <tournament> | -import file.json <competition>",
+			"Export an elimination tournament as a start.gg bracket, or import one into a competition, replaying the sets played so far", hostedCommand("startgg", hosted.ExportStartGG, hosted.ImportStartGG)},
+		"trf": {"[-o file] <competition> <tournament> | -import file.trf <competition>",
+			"Export a tournament of single players as a FIDE Tournament Report File, or import a Swiss tournament from one, carrying on from the rounds played so far", hostedCommand("trf", competition.ExportTRF, competition.ImportTRF)},
+		"json": {"[-o file] <competition> <tournament>", "Export the bracket of a tournament as JSON", exportJSON},
+	}
+}
+
+func main() {
+	dbPath := flag.String("db", "competition.db", "Database file to store competitions in")
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 0 || args[0] == "help" {
+		usage()
+		return
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n", args[0])
+		usage()
+		os.Exit(2)
+	}
+
+	engine, err := storm.NewStorageEngine(*dbPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	err = cmd.run(engine, args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", args[0], err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: competition [-db file] <command> [arguments]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %s %s\n", name, commands[name].usage)
+		fmt.Fprintf(os.Stderr, "      %s\n", commands[name].help)
+	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Tournament types:", tournamentTypeList())
+}
+
+// usageError is returned when a command is given the wrong arguments
+func usageError(name string) error {
+	return fmt.Errorf("usage: competition %s %s", name, commands[name].usage)
+}
+
+func findCompetition(engine models.StorageEngine, name string) (models.Competition, error) {
+	for _, c := range engine.GetCompetitions() {
+		if c.GetName() == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("No competition named %q", name)
+}
+
+// findTournament finds a tournament, wrapped in its format so rounds can be created
+func findTournament(engine models.StorageEngine, competition string, name string) (models.Tournament, error) {
+	c, err := findCompetition(engine, competition)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range c.GetAllTournaments() {
+		if t.GetName() != name {
+			continue
+		}
+		wrapped, err := tournament.Wrap(t)
+		if err != nil {
+			return t, nil // Can still be shown, but not advanced
+		}
+		return wrapped, nil
+	}
+	return nil, fmt.Errorf("No tournament named %q in %s", name, competition)
+}
+
+// findPlayer finds a player by name, creating them if they don't exist yet
+func findPlayer(engine models.StorageEngine, name string) models.Player {
+	for _, p := range engine.GetPlayers() {
+		if p.GetName() == name {
+			return p
+		}
+	}
+	return engine.CreatePlayer(name, nil)
+}
+
+// findArena finds an arena of a competition by name, creating it if it doesn't exist yet
+func findArena(c models.Competition, name string) models.Arena {
+	for _, a := range c.GetArenas() {
+		if a.GetName() == name {
+			return a
+		}
+	}
+	return c.CreateArena(name)
+}
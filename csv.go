@@ -0,0 +1,241 @@
+package competition
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+)
+
+// RowError is a problem with a row of a CSV file
+type RowError struct {
+	Row int // Starting at 1 and counting the header. Blank lines aren't rows
+	Err error
+}
+
+func (e RowError) Error() string {
+	return fmt.Sprintf("Row %d: %v", e.Row, e.Err)
+}
+
+func (e RowError) Unwrap() error {
+	return e.Err
+}
+
+// ImportErrors are every problem found with a CSV file. Nothing is imported from a file with problems
+type ImportErrors []RowError
+
+func (e ImportErrors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// csvRow is a row of a CSV file with its fields trimmed
+type csvRow struct {
+	number int
+	fields []string
+}
+
+// readRows reads the rows of a CSV file, leaving out the header when its first field is one of header
+func readRows(r io.Reader, header ...string) ([]csvRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var rows []csvRow
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, ImportErrors{{Row: number, Err: parseErr.Err}}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Unable to read CSV: %w", err)
+		}
+		for i := range record {
+			record[i] = strings.TrimSpace(record[i])
+		}
+		if number == 1 {
+			isHeader := false
+			for _, h := range header {
+				isHeader = isHeader || strings.EqualFold(record[0], h)
+			}
+			if isHeader {
+				continue
+			}
+		}
+		rows = append(rows, csvRow{number, record})
+	}
+}
+
+// ImportPlayers creates players from a CSV file with a row for each player: name,metadata. Metadata is a reference such as the path or URL of a photo,
+// and is stored as the player's metadata. A header row starting with name or player is skipped
+func ImportPlayers(engine models.StorageEngine, r io.Reader) ([]models.Player, error) {
+	rows, err := readRows(r, "name", "player")
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]bool{}
+	for _, p := range engine.GetPlayers() {
+		existing[p.GetName()] = true
+	}
+	var problems ImportErrors
+	seen := map[string]int{} // Row each player is on
+	for _, row := range rows {
+		name := row.fields[0]
+		switch {
+		case len(row.fields) > 2:
+			problems = append(problems, RowError{row.number, fmt.Errorf("Expected a name and metadata reference, got %d fields", len(row.fields))})
+		case name == "":
+			problems = append(problems, RowError{row.number, fmt.Errorf("Missing player name")})
+		case existing[name]:
+			problems = append(problems, RowError{row.number, fmt.Errorf("Player %q already exists", name)})
+		case seen[name] != 0:
+			problems = append(problems, RowError{row.number, fmt.Errorf("Player %q is already on row %d", name, seen[name])})
+		default:
+			seen[name] = row.number
+		}
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+
+	players := make([]models.Player, 0, len(rows))
+	for _, row := range rows {
+		var metadata []byte
+		if len(row.fields) > 1 && row.fields[1] != "" {
+			metadata = []byte(row.fields[1])
+		}
+		players = append(players, engine.CreatePlayer(row.fields[0], metadata))
+	}
+	return players, nil
+}
+
+// ImportTeams creates teams in a tournament from a CSV file with a row for each team: name,player,player... Players that don't exist yet are created.
+// A header row starting with team or name is skipped
+func ImportTeams(engine models.StorageEngine, t models.Tournament, r io.Reader) ([]models.Team, error) {
+	rows, err := readRows(r, "team", "name")
+	if err != nil {
+		return nil, err
+	}
+
+	teams := map[string]bool{}
+	playing := map[string]string{} // Team each player is on in the tournament
+	for _, team := range t.GetTeams() {
+		if models.IsByeTeam(team) {
+			continue
+		}
+		teams[team.GetName()] = true
+		for _, p := range team.GetPlayers() {
+			playing[p.GetName()] = team.GetName()
+		}
+	}
+
+	var problems ImportErrors
+	seen := map[string]int{} // Row each team is on
+	for _, row := range rows {
+		name := row.fields[0]
+		var players []string
+		for _, p := range row.fields[1:] {
+			if p != "" {
+				players = append(players, p)
+			}
+		}
+		switch {
+		case name == "":
+			problems = append(problems, RowError{row.number, fmt.Errorf("Missing team name")})
+			continue
+		case teams[name]:
+			problems = append(problems, RowError{row.number, fmt.Errorf("Team %q is already in %s", name, t.GetName())})
+			continue
+		case seen[name] != 0:
+			problems = append(problems, RowError{row.number, fmt.Errorf("Team %q is already on row %d", name, seen[name])})
+			continue
+		case len(players) == 0:
+			problems = append(problems, RowError{row.number, fmt.Errorf("Team %q has no players", name)})
+			continue
+		}
+		seen[name] = row.number
+		for _, p := range players {
+			other, ok := playing[p]
+			switch {
+			case ok && other == name:
+				problems = append(problems, RowError{row.number, fmt.Errorf("Player %q is listed twice", p)})
+			case ok:
+				problems = append(problems, RowError{row.number, fmt.Errorf("Player %q is already on %s", p, other)})
+			default:
+				playing[p] = name
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, problems
+	}
+
+	existing := map[string]models.Player{}
+	for _, p := range engine.GetPlayers() {
+		existing[p.GetName()] = p
+	}
+	created := make([]models.Team, 0, len(rows))
+	for _, row := range rows {
+		var players []models.Player
+		for _, name := range row.fields[1:] {
+			if name == "" {
+				continue
+			}
+			p, ok := existing[name]
+			if !ok {
+				p = engine.CreatePlayer(name, nil)
+				existing[name] = p
+			}
+			players = append(players, p)
+		}
+		created = append(created, t.CreateTeam(row.fields[0], players, nil))
+	}
+	return created, nil
+}
+
+// ExportResults writes a CSV file with a row for each team of each game of a tournament, in the order the games were created. Byes are left out.
+// Scores are only written for scored tournaments, and places and results once a game is over
+func ExportResults(t models.Tournament) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"round", "game", "bracket", "arena", "status", "team", "score", "place", "result"})
+	for r, round := range t.GetAllRounds() {
+		for n, g := range round.GetGames() {
+			scores := g.GetScores()
+			places := g.GetPlaces()
+			results := g.GetResults()
+			over := g.GetStatus() == models.Status_COMPLETED
+			for i, team := range g.GetTeams() {
+				if models.IsByeTeam(team) {
+					continue
+				}
+				var score, place, result string
+				if t.IsScored() && i < len(scores) {
+					score = strconv.FormatInt(scores[i], 10)
+				}
+				if over && i < len(places) {
+					place = strconv.Itoa(FlipTies(int(places[i])) + 1)
+				}
+				if over && i < len(results) {
+					result = pb.Result(results[i]).String()
+				}
+				w.Write([]string{strconv.Itoa(r + 1), strconv.Itoa(n + 1), g.GetBracket(), g.GetArena().GetName(), pb.Status(g.GetStatus()).String(), team.GetName(), score, place, result})
+			}
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
@@ -0,0 +1,114 @@
+package competition
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+func TestCSVRoundTrip(t *testing.T) {
+	e := testutil.NewEngine(t)
+	players, err := ImportPlayers(e, strings.NewReader("name,photo\nAnn,https://example.com/ann.png\n\n Bob ,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(players) != 2 || players[1].GetName() != "Bob" || string(players[0].GetMetadata()) != "https://example.com/ann.png" || players[1].GetMetadata() != nil {
+		t.Fatalf("Imported players %v, expected Ann with a photo and Bob without", players)
+	}
+
+	c := e.CreateCompetition("League", nil)
+	base := c.AddTournament("Cup", models.TournamentType_SINGLE_ELIMINATION, nil, false, 2, 1, true)
+	teams, err := ImportTeams(e, base, strings.NewReader("team,players\nAces,Ann,Cal\nBats,Bob,Dee,\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teams) != 2 || len(teams[0].GetPlayers()) != 2 || len(teams[1].GetPlayers()) != 2 {
+		t.Fatalf("Imported teams %v, expected Aces and Bats with 2 players each", teams)
+	}
+	if all := e.GetPlayers(); len(all) != 4 {
+		t.Errorf("Engine has %d players, expected the 2 imported players to be joined by Cal and Dee", len(all))
+	}
+
+	cup, err := tournament.Wrap(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	round, err := cup.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := round.GetGames()[0]
+	game.SetScores([]int64{1, 4})
+	game.SetFinal()
+
+	data, err := ExportResults(cup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, second := game.GetTeams()[0].GetName(), game.GetTeams()[1].GetName()
+	expected := [][]string{
+		{"round", "game", "bracket", "arena", "status", "team", "score", "place", "result"},
+		{"1", "1", game.GetBracket(), "", "COMPLETED", first, "1", "2", "LOSS"},
+		{"1", "1", game.GetBracket(), "", "COMPLETED", second, "4", "1", "WIN"},
+	}
+	if len(rows) != len(expected) {
+		t.Fatalf("Exported %v, expected %v", rows, expected)
+	}
+	for i := range rows {
+		if strings.Join(rows[i], ",") != strings.Join(expected[i], ",") {
+			t.Errorf("Row %d is %v, expected %v", i+1, rows[i], expected[i])
+		}
+	}
+}
+
+// rowErrors returns the rows an import failed on, nil if it didn't fail with ImportErrors
+func rowErrors(err error) []int {
+	var problems ImportErrors
+	if !errors.As(err, &problems) {
+		return nil
+	}
+	var rows []int
+	for _, p := range problems {
+		rows = append(rows, p.Row)
+	}
+	return rows
+}
+
+func TestImportMalformedRows(t *testing.T) {
+	e := testutil.NewEngine(t)
+	e.CreatePlayer("Ann", nil)
+
+	// Every problem is reported with its row, and nothing is imported: too many fields, no name, a player who exists and a player listed twice
+	_, err := ImportPlayers(e, strings.NewReader("name,photo\nBob,bob.png,extra\n,cal.png\nAnn\nDee\nDee\n"))
+	if rows := rowErrors(err); len(rows) != 4 || rows[0] != 2 || rows[1] != 3 || rows[2] != 4 || rows[3] != 6 {
+		t.Errorf("Importing players failed with %v on rows %v, expected rows [2 3 4 6]", err, rows)
+	}
+	if _, err := ImportPlayers(e, strings.NewReader("Eve,\"eve.png\n")); len(rowErrors(err)) != 1 {
+		t.Errorf("Importing a row with an unclosed quote returned %v", err)
+	}
+	if players := e.GetPlayers(); len(players) != 1 {
+		t.Errorf("Engine has %d players after failed imports, expected 1", len(players))
+	}
+
+	c := e.CreateCompetition("League", nil)
+	cup := c.AddTournament("Cup", models.TournamentType_SINGLE_ELIMINATION, nil, false, 2, 1, true)
+	cup.CreateTeam("Aces", []models.Player{e.GetPlayer("Ann")}, nil)
+	// A team without players, a team already in the tournament, a player already on a team, a player listed twice, no name and a team listed twice
+	_, err = ImportTeams(e, cup, strings.NewReader("Bats\nAces,Bob\nCats,Ann\nDogs,Dee,Dee\n,Eve\nFoxes,Fay\nFoxes,Gus\n"))
+	if rows := rowErrors(err); len(rows) != 6 || rows[0] != 1 || rows[1] != 2 || rows[2] != 3 || rows[3] != 4 || rows[4] != 5 || rows[5] != 7 {
+		t.Errorf("Importing teams failed with %v on rows %v, expected rows [1 2 3 4 5 7]", err, rows)
+	}
+	if teams := cup.GetTeams(); len(teams) != 1 || len(e.GetPlayers()) != 1 {
+		t.Errorf("Failed import left %d teams and %d players, expected only Aces and Ann", len(teams), len(e.GetPlayers()))
+	}
+}
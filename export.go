@@ -0,0 +1,145 @@
+package competition
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+)
+
+// ExportVersion is the version of the JSON schema written by ExportTournament. It changes whenever fields are removed or change meaning,
+// adding fields doesn't change it
+const ExportVersion = 1
+
+// ExportedTournament is the top level of the JSON written by ExportTournament
+type ExportedTournament struct {
+	Version   int               `json:"version"`
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Status    string            `json:"status"`
+	GameSize  uint32            `json:"gameSize"`
+	Advancing uint32            `json:"advancing"` // Teams advancing from each game
+	Scored    bool              `json:"scored"`
+	Brackets  []ExportedBracket `json:"brackets"`
+}
+
+// ExportedBracket is a bracket of a tournament, with a round for every round of the tournament, even ones where the bracket had no games
+type ExportedBracket struct {
+	Name   string          `json:"name"`
+	Winner string          `json:"winner,omitempty"` // Set once the bracket has a single winner
+	Rounds []ExportedRound `json:"rounds"`
+}
+
+type ExportedRound struct {
+	Number int            `json:"number"` // Number of the round within the tournament, starting at 1
+	Games  []ExportedGame `json:"games"`
+}
+
+type ExportedGame struct {
+	Id        string         `json:"id"`     // Identifies the game within the export, as bracket-round-game numbers starting at 1
+	Number    int            `json:"number"` // Number of the game within its bracket's round, starting at 1
+	Status    string         `json:"status"`
+	Arena     string         `json:"arena,omitempty"`
+	Start     *time.Time     `json:"start,omitempty"` // Set once the game has been scheduled
+	End       *time.Time     `json:"end,omitempty"`
+	Teams     []ExportedTeam `json:"teams"`
+	FeedsInto []ExportedLink `json:"feedsInto"` // Next games the winners of the game play
+}
+
+// ExportedTeam is how a team did in a game
+type ExportedTeam struct {
+	Name   string `json:"name"`
+	Bye    bool   `json:"bye,omitempty"`
+	Score  *int64 `json:"score,omitempty"` // Only for scored tournaments
+	Place  *int   `json:"place,omitempty"` // Place in the game starting at 1, once the game is over
+	Tied   bool   `json:"tied,omitempty"`  // Shares its place with another team
+	Winner bool   `json:"winner"`          // Advances from the game
+	Result string `json:"result"`
+}
+
+// ExportedLink points from a game to the game a team plays next
+type ExportedLink struct {
+	Team string `json:"team"`
+	Game string `json:"game"` // Id of the game
+}
+
+// exportId is the id of the game at a position in the brackets
+func exportId(p gamePosition) string {
+	return fmt.Sprintf("%d-%d-%d", p.Bracket+1, p.Round+1, p.Game+1)
+}
+
+// ExportTournament writes the brackets GenerateTournamentHTML draws as JSON, so front-ends can draw them their own way.
+// The schema is versioned with ExportVersion. Statuses, types and results are written by the names generated for them in models/storm/pb
+func ExportTournament(t models.Tournament) ([]byte, error) {
+	brackets := tournamentBrackets(t)
+	links := winnerLinks(brackets)
+
+	out := ExportedTournament{
+		Version:   ExportVersion,
+		Name:      t.GetName(),
+		Type:      pb.TournamentType(t.GetType()).String(),
+		Status:    pb.Status(t.GetStatus()).String(),
+		GameSize:  t.GetGameSize(),
+		Advancing: t.GetAdvancing(),
+		Scored:    t.IsScored(),
+		Brackets:  []ExportedBracket{},
+	}
+	for bi, b := range brackets {
+		bracket := ExportedBracket{Name: b.Name, Rounds: []ExportedRound{}}
+		if winner := b.winner(); winner != nil {
+			bracket.Winner = winner.GetName()
+		}
+		for ri, round := range b.Rounds {
+			r := ExportedRound{Number: ri + 1, Games: []ExportedGame{}}
+			for gi, game := range round {
+				position := gamePosition{bi, ri, gi}
+				g := exportGame(game, b)
+				g.Id = exportId(position)
+				g.Number = gi + 1
+				for _, link := range links[position] {
+					g.FeedsInto = append(g.FeedsInto, ExportedLink{Team: link.Team.GetName(), Game: exportId(link.To)})
+				}
+				r.Games = append(r.Games, g)
+			}
+			bracket.Rounds = append(bracket.Rounds, r)
+		}
+		out.Brackets = append(out.Brackets, bracket)
+	}
+
+	return json.MarshalIndent(out, "", "  ")
+}
+
+func exportGame(game models.Game, b Bracket) ExportedGame {
+	g := ExportedGame{
+		Status:    pb.Status(game.GetStatus()).String(),
+		Arena:     game.GetArena().GetName(),
+		Teams:     []ExportedTeam{},
+		FeedsInto: []ExportedLink{},
+	}
+	if start, end := game.GetSchedule(); !start.IsZero() {
+		g.Start, g.End = &start, &end
+	}
+	scores := game.GetScores()
+	places := game.GetPlaces()
+	for i, team := range game.GetTeams() {
+		if models.IsByeTeam(team) {
+			g.Teams = append(g.Teams, ExportedTeam{Bye: true, Result: pb.Result_UNDECIDED.String()})
+			continue
+		}
+		result, _ := game.GetTeamResult(team)
+		et := ExportedTeam{Name: team.GetName(), Winner: IsWinner(team, game, b.Advance), Result: pb.Result(result).String()}
+		if b.Scored && i < len(scores) {
+			score := scores[i]
+			et.Score = &score
+		}
+		if game.GetStatus() == models.Status_COMPLETED && i < len(places) {
+			place := FlipTies(int(places[i])) + 1
+			et.Place = &place
+			et.Tied = places[i] < 0
+		}
+		g.Teams = append(g.Teams, et)
+	}
+	return g
+}
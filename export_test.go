@@ -0,0 +1,123 @@
+package competition
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+func exportedTournament(t *testing.T, tourney models.Tournament) ExportedTournament {
+	data, err := ExportTournament(tourney)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out ExportedTournament
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// exportedGames indexes the games of an export by id
+func exportedGames(out ExportedTournament) map[string]ExportedGame {
+	games := map[string]ExportedGame{}
+	for _, b := range out.Brackets {
+		for _, r := range b.Rounds {
+			for _, g := range r.Games {
+				games[g.Id] = g
+			}
+		}
+	}
+	return games
+}
+
+// checkFeeds checks the games of an export are the expected ones, each feeding into the game given for it. Games expected with an empty link don't feed into any
+func checkFeeds(t *testing.T, games map[string]ExportedGame, expected map[string]ExportedLink) {
+	if len(games) != len(expected) {
+		t.Errorf("Exported %d games, expected %d", len(games), len(expected))
+	}
+	for id, link := range expected {
+		g, ok := games[id]
+		if !ok {
+			t.Errorf("No game %s exported", id)
+			continue
+		}
+		if link.Game == "" {
+			if len(g.FeedsInto) != 0 {
+				t.Errorf("Game %s feeds into %v, expected none", id, g.FeedsInto)
+			}
+			continue
+		}
+		if len(g.FeedsInto) != 1 || g.FeedsInto[0] != link {
+			t.Errorf("Game %s feeds into %v, expected %v", id, g.FeedsInto, link)
+		}
+	}
+}
+
+func TestExportSingleElimination(t *testing.T) {
+	out := exportedTournament(t, playTournament(t, models.TournamentType_SINGLE_ELIMINATION, fourTeams, 3))
+	if out.Version != ExportVersion || out.Type != "SINGLE_ELIMINATION" || out.Status != "COMPLETED" {
+		t.Errorf("Exported version %d of a %s tournament that is %s, expected version %d of a COMPLETED SINGLE_ELIMINATION tournament", out.Version, out.Type, out.Status, ExportVersion)
+	}
+	if len(out.Brackets) != 1 || out.Brackets[0].Winner != "Ants" {
+		t.Fatalf("Exported brackets %+v, expected one won by Ants", out.Brackets)
+	}
+	checkFeeds(t, exportedGames(out), map[string]ExportedLink{
+		"1-1-1": {"Ants", "1-2-1"},
+		"1-1-2": {"Cats", "1-2-1"},
+		"1-2-1": {},
+	})
+}
+
+func TestExportDoubleElimination(t *testing.T) {
+	out := exportedTournament(t, playTournament(t, models.TournamentType_DOUBLE_ELIMINATION, append(fourTeams, "Eels"), 10))
+	if out.Version != ExportVersion || out.Type != "DOUBLE_ELIMINATION" || out.Status != "COMPLETED" {
+		t.Errorf("Exported version %d of a %s tournament that is %s, expected version %d of a COMPLETED DOUBLE_ELIMINATION tournament", out.Version, out.Type, out.Status, ExportVersion)
+	}
+	if len(out.Brackets) != 3 || out.Brackets[2].Name != "Finals" || out.Brackets[2].Winner != "Ants" {
+		t.Fatalf("Exported brackets %+v, expected the third to be the Finals won by Ants", out.Brackets)
+	}
+	for _, b := range out.Brackets {
+		if len(b.Rounds) != 5 {
+			t.Errorf("%s has %d rounds, expected a round for each of the 5 rounds of the tournament", b.Name, len(b.Rounds))
+		}
+	}
+
+	// Winners feed into the next game they play, even in another bracket. The loser of the play-in is out
+	games := exportedGames(out)
+	expected := map[string]ExportedLink{
+		"1-1-1": {"Dogs", "1-2-1"},
+		"1-2-1": {"Ants", "1-3-1"},
+		"1-2-2": {"Cats", "1-3-1"},
+		"1-3-1": {"Ants", "3-5-1"},
+		"2-3-1": {"Dogs", "2-4-1"},
+		"2-4-1": {"Dogs", "3-5-1"},
+		"3-5-1": {},
+	}
+	checkFeeds(t, games, expected)
+
+	final := games["3-5-1"]
+	if len(final.Teams) != 2 || final.Teams[0].Result != "WIN" || final.Teams[1].Result != "LOSS" || !final.Teams[0].Winner || final.Teams[1].Winner {
+		t.Errorf("Final is %+v, expected Ants to have beaten Dogs", final.Teams)
+	}
+	if score, place := final.Teams[1].Score, final.Teams[1].Place; score == nil || *score != 1 || place == nil || *place != 2 {
+		t.Errorf("Loser of the final has score %v and place %v, expected 1 and 2", score, place)
+	}
+}
+
+func TestExportBye(t *testing.T) {
+	out := exportedTournament(t, playTournament(t, models.TournamentType_ROUND_ROBIN, fourTeams[:3], 1))
+	if out.Version != ExportVersion || out.Type != "ROUND_ROBIN" {
+		t.Errorf("Exported version %d of a %s tournament, expected version %d of a ROUND_ROBIN tournament", out.Version, out.Type, ExportVersion)
+	}
+	bye := exportedGames(out)["1-1-2"]
+	if len(bye.Teams) != 1 || bye.Teams[0].Name != "Cats" || !bye.Teams[0].Winner {
+		t.Errorf("Bye is exported with teams %+v, expected Cats to go through", bye.Teams)
+	}
+	for id, g := range exportedGames(out) {
+		if len(g.FeedsInto) != 0 {
+			t.Errorf("Game %s of a round robin feeds into %v", id, g.FeedsInto)
+		}
+	}
+}
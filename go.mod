@@ -15,4 +15,5 @@ require (
 	github.com/vmihailenco/msgpack v4.0.4+incompatible // indirect
 	go.etcd.io/bbolt v1.3.4 // indirect
 	google.golang.org/appengine v1.6.6 // indirect
+	google.golang.org/grpc v1.27.0
 )
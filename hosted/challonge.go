@@ -0,0 +1,198 @@
+package hosted
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// ChallongeTournament is a tournament as the Challonge API returns it with its participants and matches included
+type ChallongeTournament struct {
+	Tournament ChallongeDetails `json:"tournament"`
+}
+
+type ChallongeDetails struct {
+	Name           string                 `json:"name"`
+	TournamentType string                 `json:"tournament_type"` // "single elimination" or "double elimination"
+	State          string                 `json:"state"`           // "pending", "underway" or "complete"
+	Participants   []ChallongeParticipant `json:"participants"`
+	Matches        []ChallongeMatch       `json:"matches"`
+}
+
+type ChallongeParticipant struct {
+	Participant struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+		Seed int    `json:"seed"`
+	} `json:"participant"`
+}
+
+type ChallongeMatch struct {
+	Match struct {
+		ID                        int64      `json:"id"`
+		Round                     int        `json:"round"` // Negative in the losers bracket
+		State                     string     `json:"state"` // "pending", "open" or "complete"
+		Player1ID                 *int64     `json:"player1_id"`
+		Player2ID                 *int64     `json:"player2_id"`
+		Player1PrereqMatchID      *int64     `json:"player1_prereq_match_id"`
+		Player2PrereqMatchID      *int64     `json:"player2_prereq_match_id"`
+		Player1IsPrereqMatchLoser bool       `json:"player1_is_prereq_match_loser"`
+		Player2IsPrereqMatchLoser bool       `json:"player2_is_prereq_match_loser"`
+		WinnerID                  *int64     `json:"winner_id"`
+		LoserID                   *int64     `json:"loser_id"`
+		ScoresCsv                 string     `json:"scores_csv"`  // Score of each set as player1-player2, separated by commas
+		UnderwayAt                *time.Time `json:"underway_at"` // Set while the match is being played
+	} `json:"match"`
+}
+
+var challongeStates = map[models.Status]string{
+	models.Status_NEW:       "pending",
+	models.Status_ONGOING:   "underway",
+	models.Status_COMPLETED: "complete",
+}
+
+// ExportChallonge writes the games of a tournament so far as a Challonge tournament
+func ExportChallonge(t models.Tournament) ([]byte, error) {
+	b, err := toBracket(t)
+	if err != nil {
+		return nil, err
+	}
+
+	out := ChallongeDetails{Name: b.name, TournamentType: "single elimination", State: challongeStates[t.GetStatus()], Participants: []ChallongeParticipant{}, Matches: []ChallongeMatch{}}
+	if b.double {
+		out.TournamentType = "double elimination"
+	}
+	if len(b.matches) > 0 && t.GetStatus() != models.Status_COMPLETED {
+		out.State = challongeStates[models.Status_ONGOING]
+	}
+	for _, p := range b.participants {
+		var cp ChallongeParticipant
+		cp.Participant.ID, cp.Participant.Name, cp.Participant.Seed = p.id, p.name, p.seed
+		out.Participants = append(out.Participants, cp)
+	}
+	for _, m := range b.matches {
+		var cm ChallongeMatch
+		raw := &cm.Match
+		raw.ID, raw.Round = m.id, m.round
+		raw.Player1ID, raw.Player2ID = optional(m.players[0]), optional(m.players[1])
+		raw.Player1PrereqMatchID, raw.Player2PrereqMatchID = optional(m.prereqs[0].match), optional(m.prereqs[1].match)
+		raw.Player1IsPrereqMatchLoser, raw.Player2IsPrereqMatchLoser = m.prereqs[0].loser, m.prereqs[1].loser
+		switch m.status {
+		case models.Status_NEW:
+			raw.State = "open" // Games are only made once both teams are known
+		case models.Status_ONGOING:
+			raw.State = "open"
+			now := time.Now().UTC()
+			raw.UnderwayAt = &now
+		case models.Status_COMPLETED:
+			raw.State = "complete"
+			raw.WinnerID = optional(m.winner)
+			for _, id := range m.players {
+				if id != m.winner {
+					raw.LoserID = optional(id)
+				}
+			}
+		}
+		if len(m.scores) == 2 {
+			raw.ScoresCsv = fmt.Sprintf("%d-%d", m.scores[0], m.scores[1])
+		}
+		out.Matches = append(out.Matches, cm)
+	}
+	return json.MarshalIndent(ChallongeTournament{out}, "", "  ")
+}
+
+// ImportChallonge adds a Challonge tournament to a competition, replaying the matches that have been played. Matches with several sets are scored by sets won
+func ImportChallonge(engine models.StorageEngine, c models.Competition, data []byte) (models.Tournament, error) {
+	var in ChallongeTournament
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, fmt.Errorf("Invalid Challonge tournament: %w", err)
+	}
+
+	b := bracket{name: in.Tournament.Name}
+	switch in.Tournament.TournamentType {
+	case "single elimination":
+	case "double elimination":
+		b.double = true
+	default:
+		return nil, fmt.Errorf("Unsupported Challonge tournament type %q", in.Tournament.TournamentType)
+	}
+	for _, cp := range in.Tournament.Participants {
+		p := cp.Participant
+		b.participants = append(b.participants, participant{id: p.ID, name: p.Name, seed: p.Seed})
+	}
+	for _, cm := range in.Tournament.Matches {
+		raw := cm.Match
+		m := match{id: raw.ID, round: raw.Round, players: [2]int64{value(raw.Player1ID), value(raw.Player2ID)}, winner: value(raw.WinnerID)}
+		m.prereqs[0] = prereq{value(raw.Player1PrereqMatchID), raw.Player1IsPrereqMatchLoser}
+		m.prereqs[1] = prereq{value(raw.Player2PrereqMatchID), raw.Player2IsPrereqMatchLoser}
+		switch {
+		case raw.State == "complete":
+			m.status = models.Status_COMPLETED
+		case raw.UnderwayAt != nil:
+			m.status = models.Status_ONGOING
+		default:
+			m.status = models.Status_NEW
+		}
+		scores, err := parseChallongeScores(raw.ScoresCsv)
+		if err != nil {
+			return nil, fmt.Errorf("Match %d: %w", raw.ID, err)
+		}
+		m.scores = scores
+		b.matches = append(b.matches, m)
+	}
+	return b.build(engine, c)
+}
+
+// parseChallongeScores reads the scores of a match. A single set gives the scores of the set, several sets give the number of sets each player won
+func parseChallongeScores(csv string) ([]int64, error) {
+	if strings.TrimSpace(csv) == "" {
+		return nil, nil
+	}
+	sets := strings.Split(csv, ",")
+	totals := make([]int64, 2)
+	for _, set := range sets {
+		set = strings.TrimSpace(set)
+		start := 0
+		if strings.HasPrefix(set, "-") {
+			start = 1 // The first score is negative
+		}
+		cut := strings.Index(set[start:], "-")
+		if cut < 0 {
+			return nil, fmt.Errorf("Invalid scores %q", csv)
+		}
+		cut += start
+		first, err1 := strconv.ParseInt(set[:cut], 10, 64)
+		second, err2 := strconv.ParseInt(set[cut+1:], 10, 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("Invalid scores %q", csv)
+		}
+		if len(sets) == 1 {
+			return []int64{first, second}, nil
+		}
+		if first > second {
+			totals[0]++
+		} else if second > first {
+			totals[1]++
+		}
+	}
+	return totals, nil
+}
+
+// optional is nil for an id of 0, which the sites leave out
+func optional(id int64) *int64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
+
+func value(id *int64) int64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
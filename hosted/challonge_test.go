@@ -0,0 +1,101 @@
+package hosted
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+)
+
+// challongeSummary describes the matches of a Challonge tournament that have their players, by the names of the players rather than ids
+func challongeSummary(t *testing.T, data []byte) []string {
+	var in ChallongeTournament
+	if err := json.Unmarshal(data, &in); err != nil {
+		t.Fatal(err)
+	}
+	names := map[int64]string{}
+	for _, p := range in.Tournament.Participants {
+		names[p.Participant.ID] = p.Participant.Name
+	}
+	summary := []string{fmt.Sprintf("%s: %s, %s", in.Tournament.Name, in.Tournament.TournamentType, in.Tournament.State)}
+	for _, cm := range in.Tournament.Matches {
+		m := cm.Match
+		if m.Player1ID == nil || m.Player2ID == nil {
+			continue
+		}
+		players := []string{names[*m.Player1ID], names[*m.Player2ID]}
+		scores, err := parseChallongeScores(m.ScoresCsv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if players[0] > players[1] {
+			players[0], players[1] = players[1], players[0]
+			if len(scores) == 2 {
+				scores[0], scores[1] = scores[1], scores[0]
+			}
+		}
+		state := m.State
+		if state == "open" && m.UnderwayAt != nil {
+			state = "underway"
+		}
+		summary = append(summary, fmt.Sprintf("Round %d, %s vs %s: %s %v won by %q", m.Round, players[0], players[1], state, scores, names[value(m.WinnerID)]))
+	}
+	sort.Strings(summary[1:])
+	return summary
+}
+
+// withoutUnderwayTimes clears when matches got underway, as matches started here are exported with the time they are exported
+func withoutUnderwayTimes(t *testing.T, data []byte) ChallongeTournament {
+	var out ChallongeTournament
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	for i := range out.Tournament.Matches {
+		if m := &out.Tournament.Matches[i].Match; m.UnderwayAt != nil {
+			m.UnderwayAt = &time.Time{}
+		}
+	}
+	return out
+}
+
+func TestChallongeRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "challonge_double.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := testutil.NewEngine(t)
+	imported, err := ImportChallonge(e, e.CreateCompetition("Club", nil), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.GetType() != models.TournamentType_DOUBLE_ELIMINATION || imported.GetStatus() != models.Status_ONGOING {
+		t.Errorf("Imported a %v tournament that is %v, expected an ongoing double elimination", imported.GetType(), imported.GetStatus())
+	}
+	exported, err := ExportChallonge(imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := challongeSummary(t, exported), challongeSummary(t, data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Exported\n%v\nexpected\n%v", got, expected)
+	}
+
+	// Exports import as the same tournament
+	again, err := ImportChallonge(e, e.CreateCompetition("Another club", nil), exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reexported, err := ExportChallonge(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := withoutUnderwayTimes(t, reexported), withoutUnderwayTimes(t, exported); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Exported\n%s\nafter a round trip, expected\n%s", reexported, exported)
+	}
+}
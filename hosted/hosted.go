@@ -0,0 +1,346 @@
+// Package hosted converts tournaments to and from the JSON used by bracket hosting sites, so events can be moved between them and this system.
+//
+// Challonge and start.gg are supported. Both describe a bracket as participants and two player matches, each player coming either from their seed or from
+// the winner or loser of an earlier match. Only single and double elimination tournaments of two team games can be converted.
+// Tournaments are imported by replaying the matches through the tournament's format, so a tournament that is underway carries on as if it had been run here
+package hosted
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// participant is an entrant of a bracket
+type participant struct {
+	id   int64
+	name string
+	seed int // Starting at 1
+}
+
+// prereq is where a player of a match comes from
+type prereq struct {
+	match int64 // 0 when the player is seeded straight into the match
+	loser bool  // The loser of the match comes through, rather than the winner
+}
+
+// match is a match of a bracket, in the shape shared by the sites
+type match struct {
+	id      int64
+	round   int      // Starting at 1 in the winners bracket, which grand finals follow on from, and at -1 in the losers bracket
+	players [2]int64 // Ids of the participants, 0 while not known
+	prereqs [2]prereq
+	scores  []int64 // Scores of the players, empty if the match isn't scored
+	winner  int64
+	status  models.Status
+}
+
+// bracket is a tournament as the sites describe it
+type bracket struct {
+	name         string
+	double       bool
+	participants []participant
+	matches      []match
+}
+
+// Brackets of the formats, as games are labelled with them
+const (
+	losersBracket = "Losing Bracket"
+	finalsBracket = "Finals"
+)
+
+// toBracket describes the games of a tournament so far as a bracket. Byes aren't matches, players that had a bye come from the match they played before it
+func toBracket(t models.Tournament) (bracket, error) {
+	b := bracket{name: t.GetName()}
+	switch t.GetType() {
+	case models.TournamentType_SINGLE_ELIMINATION:
+	case models.TournamentType_DOUBLE_ELIMINATION:
+		b.double = true
+	default:
+		return b, fmt.Errorf("Only single and double elimination tournaments can be exported to bracket sites")
+	}
+	if t.GetGameSize() != 2 || t.GetAdvancing() != 1 {
+		return b, fmt.Errorf("Only tournaments of two team games with one winner can be exported to bracket sites")
+	}
+
+	ids := map[string]int64{}
+	for _, team := range t.GetTeams() {
+		if models.IsByeTeam(team) {
+			continue
+		}
+		p := participant{id: int64(len(b.participants) + 1), name: team.GetName(), seed: len(b.participants) + 1}
+		ids[p.name] = p.id
+		b.participants = append(b.participants, p)
+	}
+
+	last := map[int64]match{} // Last match each participant played
+	winners, losers, finals := 0, 0, 0
+	for _, round := range t.GetAllRounds() {
+		counted := map[string]bool{}
+		for _, g := range round.GetGames() {
+			var teams []models.Team
+			for _, team := range g.GetTeams() {
+				if !models.IsByeTeam(team) {
+					teams = append(teams, team)
+				}
+			}
+			if len(teams) < 2 {
+				continue
+			}
+
+			bracketName := g.GetBracket()
+			if !counted[bracketName] {
+				counted[bracketName] = true
+				switch bracketName {
+				case losersBracket:
+					losers++
+				case finalsBracket:
+					finals++
+				default:
+					winners++
+				}
+			}
+			m := match{id: int64(len(b.matches) + 1), status: g.GetStatus()}
+			switch bracketName {
+			case losersBracket:
+				m.round = -losers
+			case finalsBracket:
+				m.round = winners + finals
+			default:
+				m.round = winners
+			}
+
+			scores := g.GetScores()
+			for i, team := range teams {
+				id := ids[team.GetName()]
+				m.players[i] = id
+				if previous, ok := last[id]; ok {
+					m.prereqs[i] = prereq{match: previous.id, loser: previous.winner != id}
+				}
+				if result, _ := g.GetTeamResult(team); result == models.Result_WIN || result == models.Result_WALKOVER {
+					m.winner = id
+				}
+			}
+			if t.IsScored() {
+				for i, team := range g.GetTeams() {
+					if !models.IsByeTeam(team) && i < len(scores) {
+						m.scores = append(m.scores, scores[i])
+					}
+				}
+			}
+			for _, id := range m.players {
+				last[id] = m
+			}
+			b.matches = append(b.matches, m)
+		}
+	}
+	return b, nil
+}
+
+// build adds the bracket to a competition as a tournament, replaying its matches round by round through the tournament's format until it reaches matches still to be played.
+// Participants are added as teams of a single player, using players that already exist where the names match. The tournament is returned along with any error,
+// as it may have been partly built
+func (b bracket) build(engine models.StorageEngine, c models.Competition) (models.Tournament, error) {
+	if err := b.validate(c); err != nil {
+		return nil, err
+	}
+
+	scored := false
+	for _, m := range b.matches {
+		scored = scored || len(m.scores) > 0
+	}
+	tournamentType := models.TournamentType_SINGLE_ELIMINATION
+	if b.double {
+		tournamentType = models.TournamentType_DOUBLE_ELIMINATION
+	}
+	participants := make([]participant, len(b.participants))
+	copy(participants, b.participants)
+	sort.SliceStable(participants, func(i, j int) bool { return participants[i].seed < participants[j].seed })
+
+	players := map[string]models.Player{}
+	for _, p := range engine.GetPlayers() {
+		players[p.GetName()] = p
+	}
+	base := c.AddTournament(b.name, tournamentType, nil, true, 2, 1, scored)
+	names := map[int64]string{}
+	for _, p := range participants {
+		player, ok := players[p.name]
+		if !ok {
+			player = engine.CreatePlayer(p.name, nil)
+		}
+		base.CreateTeam(p.name, []models.Player{player}, nil)
+		names[p.id] = p.name
+	}
+	t, err := tournament.Wrap(base)
+	if err != nil {
+		return base, err
+	}
+
+	used := map[int64]bool{}
+	find := func(one string, two string) *match {
+		for i := range b.matches {
+			m := &b.matches[i]
+			if used[m.id] {
+				continue
+			}
+			x, y := names[m.players[0]], names[m.players[1]]
+			if (x == one && y == two) || (x == two && y == one) {
+				return m
+			}
+		}
+		return nil
+	}
+
+	for _, m := range b.matches {
+		if m.status != models.Status_NEW {
+			t.SetStatus(models.Status_ONGOING)
+			break
+		}
+	}
+	for t.GetStatus() != models.Status_COMPLETED {
+		round, err := t.NextRound()
+		if err != nil {
+			if t.GetStatus() == models.Status_COMPLETED {
+				break
+			}
+			return t, fmt.Errorf("Unable to rebuild round %d: %w", len(t.GetAllRounds())+1, err)
+		}
+
+		complete, played := true, false
+		for _, g := range round.GetGames() {
+			var teams []models.Team
+			for _, team := range g.GetTeams() {
+				if !models.IsByeTeam(team) {
+					teams = append(teams, team)
+				}
+			}
+			if len(teams) < 2 {
+				g.SetFinal() // Byes are over as soon as they are made
+				continue
+			}
+			m := find(teams[0].GetName(), teams[1].GetName())
+			if m == nil {
+				complete = false // Still to be played
+				continue
+			}
+			used[m.id] = true
+			played = played || m.status != models.Status_NEW
+			if err := m.apply(g, names[m.players[0]] == teams[0].GetName(), scored); err != nil {
+				return t, err
+			}
+			if g.GetStatus() != models.Status_COMPLETED {
+				complete = false
+			}
+		}
+		if !complete {
+			if played {
+				round.Start()
+			}
+			break
+		}
+		round.SetFinal()
+	}
+
+	// Matches the format didn't reach were played in a different order or bracket than the format plays them
+	var unplaced []string
+	for _, m := range b.matches {
+		if !used[m.id] && m.status != models.Status_NEW {
+			unplaced = append(unplaced, fmt.Sprintf("%d (%s vs %s)", m.id, names[m.players[0]], names[m.players[1]]))
+		}
+	}
+	if len(unplaced) > 0 {
+		return t, fmt.Errorf("Matches %s don't fit the bracket as it is played here", strings.Join(unplaced, ", "))
+	}
+	return t, nil
+}
+
+// validate checks the bracket can be added to the competition
+func (b bracket) validate(c models.Competition) error {
+	if b.name == "" {
+		return fmt.Errorf("The tournament has no name")
+	}
+	for _, t := range c.GetAllTournaments() {
+		if t.GetName() == b.name {
+			return fmt.Errorf("%s already has a tournament named %q", c.GetName(), b.name)
+		}
+	}
+	if len(b.participants) < 2 {
+		return fmt.Errorf("The tournament needs at least 2 participants")
+	}
+
+	known := map[int64]bool{}
+	names := map[string]bool{}
+	for _, p := range b.participants {
+		switch {
+		case p.name == "":
+			return fmt.Errorf("Participant %d has no name", p.id)
+		case known[p.id]:
+			return fmt.Errorf("Participant %d is listed twice", p.id)
+		case names[p.name]:
+			return fmt.Errorf("Participant %q is listed twice", p.name)
+		}
+		known[p.id] = true
+		names[p.name] = true
+	}
+	for _, m := range b.matches {
+		for _, id := range m.players {
+			if id != 0 && !known[id] {
+				return fmt.Errorf("Match %d has unknown participant %d", m.id, id)
+			}
+		}
+		if m.status == models.Status_NEW {
+			continue
+		}
+		if m.players[0] == 0 || m.players[1] == 0 {
+			return fmt.Errorf("Match %d has started without both players", m.id)
+		}
+		if m.status == models.Status_COMPLETED && m.winner != m.players[0] && m.winner != m.players[1] {
+			return fmt.Errorf("Match %d is complete without a winner", m.id)
+		}
+		if len(m.scores) != 0 && len(m.scores) != 2 {
+			return fmt.Errorf("Match %d has %d scores, expected 2", m.id, len(m.scores))
+		}
+	}
+	return nil
+}
+
+// apply brings a game to the state of the match. inOrder is set when the game lists the match's players in the same order
+func (m *match) apply(g models.Game, inOrder bool, scored bool) error {
+	scores := make([]int64, 2)
+	if len(m.scores) == 2 {
+		copy(scores, m.scores)
+	}
+	if !inOrder {
+		scores[0], scores[1] = scores[1], scores[0]
+	}
+
+	if scored && len(m.scores) == 2 {
+		g.SetScores(scores) // Scores may be kept as a match is played, before it has started here
+	}
+	if m.status == models.Status_NEW {
+		return nil
+	}
+	if m.status == models.Status_ONGOING {
+		g.Start()
+		return nil
+	}
+
+	first := m.winner == m.players[0]
+	if !inOrder {
+		first = !first
+	}
+	if scored {
+		if (scores[0] > scores[1]) != first || scores[0] == scores[1] {
+			return fmt.Errorf("The scores of match %d don't agree with its winner", m.id)
+		}
+	} else if first {
+		g.SetPlaces([]int64{0, 1})
+	} else {
+		g.SetPlaces([]int64{1, 0})
+	}
+	g.SetFinal()
+	return nil
+}
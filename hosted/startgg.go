@@ -0,0 +1,145 @@
+package hosted
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// StartGGEvent is a bracket as the start.gg API returns it for a phase group, with its entrants and sets
+type StartGGEvent struct {
+	Name        string           `json:"name"`
+	BracketType string           `json:"bracketType"` // "SINGLE_ELIMINATION" or "DOUBLE_ELIMINATION"
+	Entrants    []StartGGEntrant `json:"entrants"`
+	Sets        []StartGGSet     `json:"sets"`
+}
+
+type StartGGEntrant struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	InitialSeedNum int    `json:"initialSeedNum"`
+}
+
+type StartGGSet struct {
+	ID       int64         `json:"id"`
+	Round    int           `json:"round"` // Negative in the losers bracket
+	State    int           `json:"state"` // 1 before the set starts, 2 while it is played and 3 once it is complete
+	WinnerID *int64        `json:"winnerId"`
+	Slots    []StartGGSlot `json:"slots"`
+}
+
+// StartGGSlot is a place for an entrant in a set
+type StartGGSlot struct {
+	Entrant         *StartGGSlotEntrant `json:"entrant"`         // nil while not known
+	PrereqType      string              `json:"prereqType"`      // "seed", "set" or "bye"
+	PrereqID        int64               `json:"prereqId"`        // Id of the seed or set the entrant comes from
+	PrereqPlacement int                 `json:"prereqPlacement"` // 1 when the winner of the prereq set comes through, 2 for the loser
+	Standing        *StartGGStanding    `json:"standing,omitempty"`
+}
+
+type StartGGSlotEntrant struct {
+	ID int64 `json:"id"`
+}
+
+type StartGGStanding struct {
+	Stats struct {
+		Score struct {
+			Value *int64 `json:"value"`
+		} `json:"score"`
+	} `json:"stats"`
+}
+
+var startGGStates = map[models.Status]int{
+	models.Status_NEW:       1,
+	models.Status_ONGOING:   2,
+	models.Status_COMPLETED: 3,
+}
+
+// ExportStartGG writes the games of a tournament so far as a start.gg bracket
+func ExportStartGG(t models.Tournament) ([]byte, error) {
+	b, err := toBracket(t)
+	if err != nil {
+		return nil, err
+	}
+
+	out := StartGGEvent{Name: b.name, BracketType: "SINGLE_ELIMINATION", Entrants: []StartGGEntrant{}, Sets: []StartGGSet{}}
+	if b.double {
+		out.BracketType = "DOUBLE_ELIMINATION"
+	}
+	for _, p := range b.participants {
+		out.Entrants = append(out.Entrants, StartGGEntrant{ID: p.id, Name: p.name, InitialSeedNum: p.seed})
+	}
+	for _, m := range b.matches {
+		set := StartGGSet{ID: m.id, Round: m.round, State: startGGStates[m.status]}
+		if m.status == models.Status_COMPLETED {
+			set.WinnerID = optional(m.winner)
+		}
+		for i, id := range m.players {
+			slot := StartGGSlot{Entrant: &StartGGSlotEntrant{id}, PrereqType: "seed", PrereqID: id}
+			if p := m.prereqs[i]; p.match != 0 {
+				slot.PrereqType, slot.PrereqID, slot.PrereqPlacement = "set", p.match, 1
+				if p.loser {
+					slot.PrereqPlacement = 2
+				}
+			}
+			if len(m.scores) == 2 {
+				score := m.scores[i]
+				slot.Standing = &StartGGStanding{}
+				slot.Standing.Stats.Score.Value = &score
+			}
+			set.Slots = append(set.Slots, slot)
+		}
+		out.Sets = append(out.Sets, set)
+	}
+	return json.MarshalIndent(out, "", "  ")
+}
+
+// ImportStartGG adds a start.gg bracket to a competition, replaying the sets that have been played
+func ImportStartGG(engine models.StorageEngine, c models.Competition, data []byte) (models.Tournament, error) {
+	var in StartGGEvent
+	if err := json.Unmarshal(data, &in); err != nil {
+		return nil, fmt.Errorf("Invalid start.gg bracket: %w", err)
+	}
+
+	b := bracket{name: in.Name}
+	switch in.BracketType {
+	case "SINGLE_ELIMINATION":
+	case "DOUBLE_ELIMINATION":
+		b.double = true
+	default:
+		return nil, fmt.Errorf("Unsupported start.gg bracket type %q", in.BracketType)
+	}
+	for _, e := range in.Entrants {
+		b.participants = append(b.participants, participant{id: e.ID, name: e.Name, seed: e.InitialSeedNum})
+	}
+	for _, set := range in.Sets {
+		m := match{id: set.ID, round: set.Round, winner: value(set.WinnerID), status: models.Status_NEW}
+		switch set.State {
+		case 2:
+			m.status = models.Status_ONGOING
+		case 3:
+			m.status = models.Status_COMPLETED
+		}
+		if len(set.Slots) > 2 {
+			return nil, fmt.Errorf("Set %d has %d slots, only sets of 2 are supported", set.ID, len(set.Slots))
+		}
+		var scores []int64
+		for i, slot := range set.Slots {
+			if slot.Entrant != nil {
+				m.players[i] = slot.Entrant.ID
+			}
+			if slot.PrereqType == "set" {
+				m.prereqs[i] = prereq{slot.PrereqID, slot.PrereqPlacement == 2}
+			}
+			if slot.Standing != nil && slot.Standing.Stats.Score.Value != nil {
+				scores = append(scores, *slot.Standing.Stats.Score.Value)
+			}
+		}
+		if len(scores) == 2 {
+			m.scores = scores
+		}
+		b.matches = append(b.matches, m)
+	}
+	return b.build(engine, c)
+}
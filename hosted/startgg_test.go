@@ -0,0 +1,86 @@
+package hosted
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+)
+
+// startGGSummary describes the sets of a start.gg bracket that have both entrants, by the names of the entrants rather than ids
+func startGGSummary(t *testing.T, data []byte) []string {
+	var in StartGGEvent
+	if err := json.Unmarshal(data, &in); err != nil {
+		t.Fatal(err)
+	}
+	names := map[int64]string{}
+	for _, e := range in.Entrants {
+		names[e.ID] = e.Name
+	}
+	summary := []string{fmt.Sprintf("%s: %s", in.Name, in.BracketType)}
+	for _, set := range in.Sets {
+		var players []string
+		var scores []int64
+		for _, slot := range set.Slots {
+			if slot.Entrant != nil {
+				players = append(players, names[slot.Entrant.ID])
+			}
+			if slot.Standing != nil && slot.Standing.Stats.Score.Value != nil {
+				scores = append(scores, *slot.Standing.Stats.Score.Value)
+			}
+		}
+		if len(players) != 2 {
+			continue
+		}
+		if players[0] > players[1] {
+			players[0], players[1] = players[1], players[0]
+			if len(scores) == 2 {
+				scores[0], scores[1] = scores[1], scores[0]
+			}
+		}
+		summary = append(summary, fmt.Sprintf("Round %d, %s vs %s: state %d %v won by %q", set.Round, players[0], players[1], set.State, scores, names[value(set.WinnerID)]))
+	}
+	sort.Strings(summary[1:])
+	return summary
+}
+
+func TestStartGGRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "startgg_single.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := testutil.NewEngine(t)
+	imported, err := ImportStartGG(e, e.CreateCompetition("Club", nil), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.GetType() != models.TournamentType_SINGLE_ELIMINATION || imported.GetStatus() != models.Status_ONGOING {
+		t.Errorf("Imported a %v tournament that is %v, expected an ongoing single elimination", imported.GetType(), imported.GetStatus())
+	}
+	exported, err := ExportStartGG(imported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, expected := startGGSummary(t, exported), startGGSummary(t, data); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Exported\n%v\nexpected\n%v", got, expected)
+	}
+
+	// Exports import as the same tournament
+	again, err := ImportStartGG(e, e.CreateCompetition("Another club", nil), exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reexported, err := ExportStartGG(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(reexported) != string(exported) {
+		t.Errorf("Exported\n%s\nafter a round trip, expected\n%s", reexported, exported)
+	}
+}
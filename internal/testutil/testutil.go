@@ -0,0 +1,28 @@
+// Package testutil holds the setup shared by the tests of the other packages.
+//
+// The tests of models/storm can't use it, as it imports that package, so they open their databases themselves
+package testutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm"
+)
+
+// NewEngine opens a storage engine in a fresh database that is removed once the test is done
+func NewEngine(t testing.TB) models.StorageEngine {
+	dir, err := ioutil.TempDir("", "competition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	e, err := storm.NewStorageEngine(filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
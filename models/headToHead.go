@@ -0,0 +1,161 @@
+package models
+
+// Matchup is how one side has done against another in the completed games where they played each other. Wins, losses and draws are from the first side's point of view
+type Matchup struct {
+	Played        int
+	Wins          int // Including walkovers
+	Losses        int // Including forfeits and disqualifications
+	Draws         int // Including games where neither finished ahead of the other
+	PointsFor     int64
+	PointsAgainst int64 // Only from scored games
+	Games         []Game
+}
+
+// HeadToHead finds the completed games two teams played against each other, from the records of the first team
+func HeadToHead(a Team, b Team) Matchup {
+	var m Matchup
+	if IsByeTeam(a) || IsByeTeam(b) || a.Equals(b) {
+		return m
+	}
+	for _, g := range a.GetRecords() {
+		if g.GetStatus() != Status_COMPLETED {
+			continue
+		}
+		ai, bi := -1, -1
+		for i, t := range g.GetTeams() {
+			if IsByeTeam(t) {
+				continue
+			}
+			if t.Equals(a) {
+				ai = i
+			} else if t.Equals(b) {
+				bi = i
+			}
+		}
+		if ai >= 0 && bi >= 0 {
+			m.add(g, ai, bi)
+		}
+	}
+	return m
+}
+
+// PlayerHeadToHead finds the completed games two players played on opposing teams, from the records of the first player.
+// Games where the players were teammates aren't counted
+func PlayerHeadToHead(a Player, b Player) Matchup {
+	var m Matchup
+	if a.GetName() == b.GetName() {
+		return m
+	}
+	// Records only hold the games a player was on the roster for, so games missing from either player's records weren't between them
+	played := map[uint64]bool{}
+	for _, g := range b.GetRecords() {
+		played[g.GetId()] = true
+	}
+	for _, g := range a.GetRecords() {
+		if g.GetStatus() != Status_COMPLETED || !played[g.GetId()] {
+			continue
+		}
+		ai, bi := -1, -1
+		for i, t := range g.GetTeams() {
+			if IsByeTeam(t) {
+				continue
+			}
+			onA, onB := onRoster(t, a), onRoster(t, b)
+			if onA && onB {
+				ai, bi = -1, -1 // Teammates
+				break
+			}
+			if onA {
+				ai = i
+			} else if onB {
+				bi = i
+			}
+		}
+		if ai >= 0 && bi >= 0 {
+			m.add(g, ai, bi)
+		}
+	}
+	return m
+}
+
+// add counts a game between the teams at index a and b of the game
+func (m *Matchup) add(g Game, a int, b int) {
+	m.Played++
+	m.Games = append(m.Games, g)
+	switch beats(g, a, b) {
+	case 1:
+		m.Wins++
+	case -1:
+		m.Losses++
+	default:
+		m.Draws++
+	}
+	if scores := g.GetScores(); g.IsScored() && a < len(scores) && b < len(scores) {
+		m.PointsFor += scores[a]
+		m.PointsAgainst += scores[b]
+	}
+}
+
+// beats compares how the teams at index a and b of a completed game did against each other: 1 if a finished ahead, -1 if b did, and 0 if neither did.
+// Results are compared first, then scores in scored games, then places when neither team tied
+func beats(g Game, a int, b int) int {
+	results := g.GetResults()
+	if a >= len(results) || b >= len(results) {
+		return 0
+	}
+	ra, rb := resultRank(results[a]), resultRank(results[b])
+	if ra != rb {
+		if ra < rb {
+			return 1
+		}
+		return -1
+	}
+	if ra == resultRank(Result_FORFEIT) {
+		return 0 // Neither played the game out
+	}
+
+	if g.IsScored() {
+		if scores := g.GetScores(); a < len(scores) && b < len(scores) && scores[a] != scores[b] {
+			if scores[a] > scores[b] {
+				return 1
+			}
+			return -1
+		}
+		return 0
+	}
+	if places := g.GetPlaces(); a < len(places) && b < len(places) && places[a] >= 0 && places[b] >= 0 && places[a] != places[b] {
+		if places[a] < places[b] {
+			return 1
+		}
+		return -1
+	}
+	return 0
+}
+
+// resultRank orders results from best to worst
+func resultRank(r Result) int {
+	switch r {
+	case Result_WIN, Result_WALKOVER:
+		return 0
+	case Result_DRAW:
+		return 1
+	case Result_FORFEIT, Result_DISQUALIFIED:
+		return 3
+	}
+	return 2
+}
+
+// onRoster determines if the player is, or has been, on the team's roster
+func onRoster(t Team, p Player) bool {
+	for _, member := range t.GetPlayers() {
+		if member.GetName() == p.GetName() {
+			return true
+		}
+	}
+	for _, change := range t.GetRosterChanges() {
+		if change.Player.GetName() == p.GetName() {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"errors"
+	"time"
+)
+
 // Status is the basic status for tournaments, rounds, and games
 type Status int32
 
@@ -21,6 +26,46 @@ const (
 	TournamentType_GROUP_PLAY         TournamentType = 5
 )
 
+// Result is how a game ended for a single team
+type Result int32
+
+const (
+	Result_UNDECIDED    Result = 0 // Game isn't over yet
+	Result_WIN          Result = 1
+	Result_LOSS         Result = 2
+	Result_DRAW         Result = 3
+	Result_FORFEIT      Result = 4 // Team forfeited the game
+	Result_DISQUALIFIED Result = 5 // Team was disqualified from the game
+	Result_WALKOVER     Result = 6 // Team won because every opponent forfeited or was disqualified
+)
+
+// EventAction is the kind of change an Event records
+type EventAction int32
+
+const (
+	EventAction_CREATED EventAction = 0
+	EventAction_UPDATED EventAction = 1
+	EventAction_DELETED EventAction = 2
+)
+
+// Event is a single change to the stored state of a competition. Replaying every event in order rebuilds the state
+type Event struct {
+	Id       uint64 // Position of the event in the log
+	Time     time.Time
+	Action   EventAction
+	Kind     string // Type of record that changed, eg "Team" or "Game"
+	RecordId uint64
+	Field    string // Field that was changed, empty if the whole record was saved
+	Command  uint64 // Action that caused the change, all of the changes from a single action share a command
+	Data     []byte // The record after the change, or before it was deleted
+}
+
+// ErrLaterRounds is returned when correcting a game whose result was used to create later rounds that have already been played
+var ErrLaterRounds = errors.New("Later rounds depend on the result of this game")
+
+// ErrNoArenas is returned when assigning arenas to the games of a competition that doesn't have any
+var ErrNoArenas = errors.New("No arenas to assign games to")
+
 // StorageEngine is a backing that provides storing details for an active competition
 type StorageEngine interface {
 	CreateCompetition(name string, players []Player) Competition
@@ -29,6 +74,18 @@ type StorageEngine interface {
 	GetCompetitions() []Competition
 	GetPlayers() []Player
 	GetPlayer(name string) Player
+	GetEvents() []Event                     // Every change made to the stored state, oldest first
+	Subscribe(func(Change)) (cancel func()) // Be notified whenever a game's scores, places, status, arena, bracket, schedule or officials change
+}
+
+// Change is a notification that a game has changed. Changes made by a single action are combined into one Change per game
+type Change struct {
+	Competition string   // Name of the competition the game is in
+	Tournament  string   // Name of the tournament the game is in
+	Round       int      // Number of the round within the tournament, starting at 1
+	GameNumber  int      // Number of the game within the round, starting at 1
+	Fields      []string // What changed: "Scores", "Places", "Status", "Arena", "Bracket", "Schedule" and/or "Officials"
+	Game        Game     // The game after the change, nil if it was removed
 }
 
 // Competition is the broadest category here. It can contain multiple tournaments
@@ -39,7 +96,16 @@ type Competition interface {
 	CreateArena(name string) Arena
 	GetAllTournaments() []Tournament
 	GetArenas() []Arena
+	AssignArenas() error // Queue every game waiting to be played without an arena at the arena with the fewest games waiting
+
+	CreateOfficial(name string, player Player) Official // player is who the official plays as, if they also play. nil if they don't
+	TeamOfficial(t Team) Official                       // Official for a team officiating as a whole, as when teams ref each other
+	GetOfficials() []Official
+	SetLosersRef(role string) // The losing team of each game at an arena takes role in the next game waiting there. An empty role turns this off
+	GetLosersRef() string
 	GetName() string
+	Undo() error // Revert the most recent action, like a round being created or a score being entered
+	Redo() error // Apply the most recently undone action again, fails if anything else has been done since it was undone
 }
 
 // Tournament provides a single competitive type of event, all rankings/matches are of the same type within the tournament
@@ -50,9 +116,10 @@ type Tournament interface {
 	GetAllRounds() []Round
 	GetName() string
 	GetType() TournamentType
-	SetMetadata([]byte)        //store match times in here
-	GetMetadata() []byte       //store match times in here
+	SetMetadata([]byte)        //store extra data about the tournament in here, game times are set with Game.Schedule
+	GetMetadata() []byte       //store extra data about the tournament in here, game times are set with Game.Schedule
 	GetBracketOrder() []string // Get Display/importance order of brackets
+	DependsOnResults() bool    // Later rounds are made from the results of earlier rounds, as in elimination and Swiss, so correcting a result changes them
 	GetTeams() []Team
 	IsScored() bool
 	CreateTeam(name string, players []Player, metadata []byte) Team //If no players are provided, the team will be used as a BYE team
@@ -63,28 +130,52 @@ type Tournament interface {
 	GetStatus() Status
 	SetFinal()
 	GetTeam(name string) Team
+	Withdraw(Team)         // Team pulls out of the tournament, any games it hasn't finished are forfeited
+	SetForfeitScore(int64) // Score given to the opponents of a team that forfeits, in scored tournaments
+	GetForfeitScore() int64
+	SetRoundCount(uint32) // Rounds to be played, for formats that play a set number of rounds like Swiss. 0 lets the format decide
+	GetRoundCount() uint32
+	RemoveLastRound() error                              // Delete the most recent round and all of its games
+	Record(name string, fn func(Tournament) error) error // Make the changes of fn, through the tournament it is given, as a single action so they are undone together. Nothing is kept if fn fails
 }
 
 // Round is a single round within a tournament
 type Round interface {
-	CreateGame(teams []Team, scored bool) Game
+	CreateGame(teams []Team, scored bool) Game // Teams that have withdrawn from the tournament forfeit the game as soon as it is created
 	GetGames() []Game
 	SetFinal() // Round is over, set all games to final/complete & lock in whatever scores/places are in place
 	Start()
 	SetStatus(Status)
 	GetStatus() Status
+	SetSeriesLength(uint32) // Play each game in this round as a best-of-N series, applies to games that haven't started yet
+	GetSeriesLength() uint32
+	Schedule([]Booking)           // Give games of the round an arena and a time slot, all as a single action
+	AssignOfficials([]Assignment) // Give games of the round their officials, all as a single action
+}
+
+// Booking is a time slot in an arena for a game
+type Booking struct {
+	Game  Game
+	Arena Arena
+	Start time.Time
+	End   time.Time
 }
 
 // Game is a single competitive event
 type Game interface {
+	GetId() uint64 // Stored id of the game, stays the same for as long as the game exists
 	GetTeams() []Team
 	GetStatus() Status
 	SetStatus(Status)
-	SetScores([]int64) //map of teamIds to scores // Or should I map team names to scores?
-	SetPlaces([]int64) //map of teamIds to places (Only should be called if game is not scored)
+	SetScores([]int64) //map of teamIds to scores // Or should I map team names to scores? Ignored once the game is final, use Correct
+	SetPlaces([]int64) //map of teamIds to places (Only should be called if game is not scored) Ignored once the game is final, use Correct. A team playing alone that is given a tied place draws, like a half point bye
 	SetFinal()         // Game is over, lock in whatever scores/places are in place
 	GetArena() Arena
 	SetArena(Arena)
+	Schedule(arena Arena, start time.Time, end time.Time) // Book the game into an arena for a time slot
+	GetSchedule() (start time.Time, end time.Time)        // Zero times if the game hasn't been scheduled
+	AssignOfficial(o Official, role string)               // Give the game an official in a role, eg "Referee". Replaces whoever had the role before
+	GetOfficials() []Assignment
 	Start()
 	GetBracket() string
 	SetBracket(string)
@@ -94,16 +185,66 @@ type Game interface {
 
 	GetTeamPlace(t Team) int64
 	GetTeamScore(t Team) int64
+
+	SetPeriods(Periods) error // Store the score by period, set or inning. The game's scores are set to the totals of the periods. Every period needs a score for each team
+	GetPeriods() Periods
+
+	Forfeit(t Team, reason string)         // Team forfeits the game. If only one team is left, it wins by walkover and the game is over. Ignored once the game is final, use Correct
+	ForfeitAll(reason string)              // Every team forfeits the game, so no one wins and the game is over. Ignored once the game is final
+	Disqualify(t Team, reason string)      // Team is disqualified from the game. If only one team is left, it wins by walkover and the game is over. Ignored once the game is final, use Correct
+	GetResults() []Result                  // How the game ended for each team
+	GetTeamResult(t Team) (Result, string) // How the game ended for a team, and why if it forfeited or was disqualified
+
+	SetSeriesLength(uint32) // Play this game as a best-of-N series of sub-games
+	GetSeriesLength() uint32
+	GetSeries() Series // nil if the game isn't played as a series
+
+	Correct(official string, scores []int64, places []int64) error // Change the result of a completed game, nil scores or places are left as they are. Use tournament.CorrectGame to update later rounds
+	GetCorrections() []Correction                                  // Every correction made to the game, oldest first
+}
+
+// Correction is an official change to the result of a completed game
+type Correction struct {
+	Official  string
+	Time      time.Time
+	OldScores []int64
+	NewScores []int64
+	OldPlaces []int64
+	NewPlaces []int64
+}
+
+// Series is a best-of-N matchup played over several sub-games (maps, sets) between the teams of a single Game.
+// Each sub-game is scored on its own, and the series' overall result is stored as the scores and places of the parent Game
+type Series interface {
+	GetLength() uint32       // Most sub-games that will be played, eg 3 for a best-of-3
+	GetGames() []Game        // Sub-games that have been created so far
+	NextGame() (Game, error) // Create the next sub-game, fails if the series has been clinched or the current sub-game is still being played
+	GetWins() []int64        // Sub-games won by each team, in the same order as the parent Game's teams
+	IsClinched() bool        // A team has won enough sub-games that it can't be caught
 }
 
 // Team is a participant in a Game, that is part of a competition
 type Team interface {
+	GetId() uint64 // Stored id of the team. Teams in different tournaments can share a name, but never an id
 	GetPlayers() []Player
 	GetName() string
 	SetMetadata([]byte)  //store images in here
 	GetMetadata() []byte // Store images in here
 	GetRecords() []Game
 	Equals(Team) bool
+	IsWithdrawn() bool
+
+	AddPlayer(Player)                       // Add a player to the roster, can be done at any point in the tournament
+	RemovePlayer(Player)                    // Remove a player from the roster, the team keeps its records
+	SubstitutePlayer(out Player, in Player) // Swap one player on the roster for another
+	GetRosterChanges() []RosterChange       // All changes to the roster, in the order they were made
+}
+
+// RosterChange records a player being added to or removed from a team
+type RosterChange struct {
+	Player Player
+	Added  bool
+	Time   time.Time
 }
 
 // Player is part of a competition, and can be on teams that participate in competitions
@@ -112,10 +253,55 @@ type Player interface {
 	SetMetadata([]byte)  //store images in here
 	GetMetadata() []byte // Store Images in here
 	GetRecords() []Game
+	GetStats() PlayerStats // How the player has done in completed games of every competition
+}
+
+// Stats are a record of completed games. Byes aren't counted
+type Stats struct {
+	Played        int
+	Wins          int // Including walkovers
+	Losses        int // Including forfeits and disqualifications
+	Draws         int
+	PointsFor     int64 // Only from scored tournaments
+	PointsAgainst int64
+	Titles        int // Finished tournaments won
+	BestFinish    int // Best place in a finished tournament, starting at 1. 0 if no tournament has finished
+}
+
+// WinRate is the share of games played that were won, from 0 to 1
+func (s Stats) WinRate() float64 {
+	if s.Played == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Played)
+}
+
+// PlayerStats are a player's career stats, along with the same stats broken down
+type PlayerStats struct {
+	Total            Stats
+	ByCompetition    map[string]Stats         // By name of the competition
+	ByTournamentType map[TournamentType]Stats // By the type of tournament played in
+	ByTeammate       map[string]Stats         // By name of the other players on the team the player played for. Titles and finishes are shared the same way
+}
+
+// Official referees, keeps score or otherwise runs games. Officials aren't given games that their own team plays in
+type Official interface {
+	GetName() string
+	GetPlayer() Player // Who the official plays as, nil if they don't play
+	GetTeam() Team     // Team officiating as a whole, nil for a single person
+	GetGames() []Game  // Games the official has been given
+}
+
+// Assignment is an official's role in a game
+type Assignment struct {
+	Game     Game
+	Official Official
+	Role     string
 }
 
 // Arena is a place for the events to be held at
 type Arena interface {
 	GetName() string
-	GetGames() []Game
+	GetGames() []Game // Games being played or waiting to be played at the arena, in the order they'll be played
+	NextGame() Game   // Game being played at the arena, or else the first waiting game whose teams are all free. nil if there isn't one
 }
@@ -0,0 +1,83 @@
+package models
+
+import "fmt"
+
+// PeriodType decides how the total score of a game is derived from its period scores
+type PeriodType int32
+
+const (
+	PeriodType_POINTS PeriodType = 0 // Total is the sum of every period, like quarters, halves or innings
+	PeriodType_SETS   PeriodType = 1 // Total is the number of periods won, like tennis or volleyball sets
+)
+
+// Periods is the breakdown of a game's score by period, set or inning
+type Periods struct {
+	Type       PeriodType
+	Regulation int       // Periods in regulation, any periods after these are overtime or extra innings. 0 if the game has no fixed length
+	Scores     [][]int64 // Scores[period][team], teams are in the same order as the Game's teams
+}
+
+// Totals returns the final score for each team that the periods add up to
+func (p Periods) Totals() []int64 {
+	var totals []int64
+	for _, period := range p.Scores {
+		if len(period) > len(totals) {
+			totals = append(totals, make([]int64, len(period)-len(totals))...)
+		}
+		switch p.Type {
+		case PeriodType_SETS:
+			if winner := periodWinner(period); winner >= 0 {
+				totals[winner]++
+			}
+		default:
+			for i, score := range period {
+				totals[i] += score
+			}
+		}
+	}
+	return totals
+}
+
+// IsOvertime determines if the period was played after regulation
+func (p Periods) IsOvertime(period int) bool {
+	return p.Regulation > 0 && period >= p.Regulation
+}
+
+// Label returns a short display name for the period, overtime periods are labeled OT, 2OT, and so on
+func (p Periods) Label(period int) string {
+	if !p.IsOvertime(period) {
+		return fmt.Sprint(period + 1)
+	}
+	overtime := period - p.Regulation + 1
+	if overtime == 1 {
+		return "OT"
+	}
+	return fmt.Sprintf("%dOT", overtime)
+}
+
+// TeamScores returns a single team's score for every period
+func (p Periods) TeamScores(team int) []int64 {
+	scores := make([]int64, len(p.Scores))
+	for i, period := range p.Scores {
+		if team < len(period) {
+			scores[i] = period[team]
+		}
+	}
+	return scores
+}
+
+// periodWinner returns the index of the team that won the period, or -1 if the period was tied
+func periodWinner(period []int64) int {
+	winner := -1
+	for i, score := range period {
+		if winner < 0 || score > period[winner] {
+			winner = i
+		}
+	}
+	for i, score := range period {
+		if i != winner && score == period[winner] {
+			return -1
+		}
+	}
+	return winner
+}
@@ -0,0 +1,37 @@
+package models
+
+import "testing"
+
+func TestPeriodTotals(t *testing.T) {
+	tests := []struct {
+		periods Periods
+		totals  []int64
+	}{
+		{Periods{Type: PeriodType_POINTS, Scores: [][]int64{{7, 3}, {0, 10}, {14, 7}}}, []int64{21, 20}},
+		{Periods{Type: PeriodType_SETS, Scores: [][]int64{{6, 4}, {3, 6}, {7, 6}}}, []int64{2, 1}},
+		{Periods{Type: PeriodType_SETS, Scores: [][]int64{{6, 6}, {6, 2}}}, []int64{1, 0}},
+		{Periods{Type: PeriodType_POINTS}, nil},
+	}
+	for _, test := range tests {
+		totals := test.periods.Totals()
+		if len(totals) != len(test.totals) {
+			t.Errorf("Totals of %v are %v, expected %v", test.periods.Scores, totals, test.totals)
+			continue
+		}
+		for i := range totals {
+			if totals[i] != test.totals[i] {
+				t.Errorf("Totals of %v are %v, expected %v", test.periods.Scores, totals, test.totals)
+				break
+			}
+		}
+	}
+}
+
+func TestPeriodLabel(t *testing.T) {
+	p := Periods{Regulation: 4}
+	for period, label := range []string{"1", "2", "3", "4", "OT", "2OT"} {
+		if got := p.Label(period); got != label {
+			t.Errorf("Period %d is labeled %s, expected %s", period, got, label)
+		}
+	}
+}
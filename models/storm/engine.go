@@ -3,6 +3,7 @@ package storm
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/justinjudd/competition/models"
 	"github.com/justinjudd/competition/models/storm/pb"
@@ -13,7 +14,7 @@ import (
 )
 
 type engine struct {
-	*storm.DB
+	*store
 }
 
 // NewStorageEngine creates and returns a StorageEngine meeting the engine interface, using a storm db backend
@@ -24,67 +25,74 @@ func NewStorageEngine(path string) (models.StorageEngine, error) {
 		return nil, fmt.Errorf("Unable to open storage engine: %w", err)
 	}
 
-	return &engine{db}, nil
+	return &engine{newStore(db)}, nil
 
 }
 
 type competition struct {
 	pb.Competition
-	*storm.DB
+	*store
 }
 
 type tournament struct {
 	pb.Tournament
-	*storm.DB
+	*store
 }
 
 type arena struct {
 	pb.Arena
-	*storm.DB
+	*store
 }
 
 type player struct {
 	pb.Player
-	*storm.DB
+	*store
 }
 
 type team struct {
 	pb.Team
-	*storm.DB
+	*store
 }
 
 type round struct {
 	pb.Round
-	*storm.DB
+	*store
 }
 
 type game struct {
 	pb.Game
-	*storm.DB
+	*store
 }
 
 func (e *engine) CreateCompetition(name string, players []models.Player) models.Competition {
-	c := competition{DB: e.DB}
-	c.Name = name
-	err := e.Save(&c.Competition)
-	if err != nil {
-		fmt.Println("Unable to create competition:", err)
-	}
+	c := competition{store: e.store}
+	e.command("CreateCompetition", func(e *engine) error {
+		c.store = e.store
+		c.Name = name
+		err := e.Save(&c.Competition)
+		if err != nil {
+			fmt.Println("Unable to create competition:", err)
+		}
+		return nil
+	})
 
 	return &c
 }
 
 func (e *engine) CreatePlayer(name string, metadata []byte) models.Player {
-	p := pb.Player{Name: name, Metadata: metadata}
-	e.Save(&p)
-	return &player{p, e.DB}
+	p := player{pb.Player{Name: name, Metadata: metadata}, e.store}
+	e.command("CreatePlayer", func(e *engine) error {
+		p.store = e.store
+		return e.Save(&p.Player)
+	})
+	return &p
 }
 
 func (e *engine) GetCompetitions() []models.Competition {
 	var comps []models.Competition
 	e.Select().Each(new(pb.Competition), func(record interface{}) error {
 		c := record.(*pb.Competition)
-		comps = append(comps, &competition{*c, e.DB})
+		comps = append(comps, &competition{*c, e.store})
 		return nil
 	})
 	return comps
@@ -94,7 +102,7 @@ func (e *engine) GetPlayers() []models.Player {
 	var players []models.Player
 	e.Select().Each(new(pb.Player), func(record interface{}) error {
 		p := record.(*pb.Player)
-		players = append(players, &player{*p, e.DB})
+		players = append(players, &player{*p, e.store})
 		return nil
 	})
 	return players
@@ -108,20 +116,24 @@ func (e *engine) GetPlayer(name string) models.Player {
 		fmt.Println("Error getting matching player:", err)
 		return nil
 	}
-	return &player{p, e.DB}
+	return &player{p, e.store}
 }
 
 func (c *competition) AddTournament(name string, tournamentType models.TournamentType, teams []models.Team, seeded bool, gameSize uint32, advancing uint32, scored bool) models.Tournament {
-	//t := tournament{DB: c.DB}
-	t := pb.Tournament{Name: name, Type: pb.TournamentType(tournamentType), CompetitionId: c.GetId(), Seeded: seeded, GameSize: gameSize, Advancing: advancing, Scored: scored}
-	err := c.Save(&t)
-	if err != nil {
-		fmt.Println("Error saving tournament:", err)
-	}
-	tourney := tournament{t, c.DB}
-	for _, team := range teams {
-		tourney.CreateTeam(team.GetName(), team.GetPlayers(), team.GetMetadata())
-	}
+	var tourney tournament
+	c.command("AddTournament", func(c *competition) error {
+		//t := tournament{DB: c.DB}
+		t := pb.Tournament{Name: name, Type: pb.TournamentType(tournamentType), CompetitionId: c.GetId(), Seeded: seeded, GameSize: gameSize, Advancing: advancing, Scored: scored}
+		err := c.Save(&t)
+		if err != nil {
+			fmt.Println("Error saving tournament:", err)
+		}
+		tourney = tournament{t, c.store}
+		for _, team := range teams {
+			tourney.CreateTeam(team.GetName(), team.GetPlayers(), team.GetMetadata())
+		}
+		return nil
+	})
 	return &tourney
 }
 
@@ -135,14 +147,14 @@ func (c *competition) GetActiveTournament() models.Tournament {
 	}
 	active := t[0]
 
-	return &tournament{active, c.DB}
+	return &tournament{active, c.store}
 }
 
 func (c *competition) GetAllTournaments() []models.Tournament {
 	var tournies []models.Tournament
 	err := c.Select(q.Eq("CompetitionId", c.Id)).Each(new(pb.Tournament), func(record interface{}) error {
 		t := record.(*pb.Tournament)
-		tournies = append(tournies, &tournament{*t, c.DB})
+		tournies = append(tournies, &tournament{*t, c.store})
 		return nil
 	})
 	if err != nil {
@@ -152,41 +164,42 @@ func (c *competition) GetAllTournaments() []models.Tournament {
 }
 
 func (c *competition) GetArenas() []models.Arena {
-	var pbArenas []pb.Arena
-	err := c.All(&pbArenas)
-	if err != nil {
-
-	}
+	pbArenas := c.competitionArenas(c.Id)
 	arenas := make([]models.Arena, len(pbArenas))
 	for i, a := range pbArenas {
-		arenas[i] = &arena{a, c.DB}
+		arenas[i] = &arena{a, c.store}
 	}
 	return arenas
 }
 
 func (c *competition) CreateArena(name string) models.Arena {
-	a := pb.Arena{Name: name}
-	c.Save(&a)
-	return &arena{a, c.DB}
+	a := arena{pb.Arena{Name: name, CompetitionId: c.Id}, c.store}
+	c.command("CreateArena", func(c *competition) error {
+		a.store = c.store
+		return c.Save(&a.Arena)
+	})
+	return &a
 }
 
 func (a *arena) GetGames() []models.Game {
-	var games []pb.Game
-	a.Select(q.Eq("ArenaId", a.Id), q.In("Status", []pb.Status{pb.Status_NEW, pb.Status_ONGOING})).Find(&games)
+	games := a.queue()
 
 	outGames := make([]models.Game, len(games))
 	for i, g := range games {
-		outGames[i] = &game{g, a.DB}
+		outGames[i] = &game{g, a.store}
 	}
 
 	return outGames
 }
 
 func (p *player) SetMetadata(metadata []byte) {
-	p.Metadata = metadata
-	p.UpdateField(&p.Player, "Metadata", metadata)
+	p.command("SetMetadata", func(p *player) error {
+		p.Metadata = metadata
+		return p.UpdateField(&p.Player, "Metadata", metadata)
+	})
 }
 
+// GetRecords finds the games of the player's teams that were played while the player was on the roster
 func (p *player) GetRecords() []models.Game {
 	var teamIds []uint64
 	p.Select(q.Eq("PlayerId", p.Id)).Each(new(pb.PlayerTeam), func(record interface{}) error {
@@ -194,28 +207,74 @@ func (p *player) GetRecords() []models.Game {
 		teamIds = append(teamIds, pt.TeamId)
 		return nil
 	})
+	changes := p.rosterChanges()
 	var gameIds []uint64
+	gameTeams := map[uint64][]uint64{}
 	var games []models.Game
 	p.Select(q.In("TeamId", teamIds)).Each(new(pb.GameTeam), func(record interface{}) error {
 		gt := record.(*pb.GameTeam)
 		gameIds = append(gameIds, gt.GameId)
+		gameTeams[gt.GameId] = append(gameTeams[gt.GameId], gt.TeamId)
 		return nil
 	})
-	p.Select(q.In("Id", gameIds)).Each(new(pb.Game), func(record interface{}) error {
+	p.Select(q.In("Id", gameIds), q.Eq("ParentId", uint64(0))).Each(new(pb.Game), func(record interface{}) error {
 		g := record.(*pb.Game)
-		games = append(games, &game{*g, p.DB})
+		for _, teamId := range gameTeams[g.Id] {
+			if playedFor(changes[teamId], *g) {
+				games = append(games, &game{*g, p.store})
+				break
+			}
+		}
 		return nil
 	})
 	return games
 }
 
+// rosterChanges loads the player's roster changes for each team, in the order they were made
+func (p *player) rosterChanges() map[uint64][]pb.RosterChange {
+	changes := map[uint64][]pb.RosterChange{}
+	var rcs []pb.RosterChange
+	p.Select(q.Eq("PlayerId", p.Id)).OrderBy("Id").Find(&rcs)
+	for _, rc := range rcs {
+		changes[rc.TeamId] = append(changes[rc.TeamId], rc)
+	}
+	return changes
+}
+
+// playedFor determines if a player was on a team for a game, from their roster changes for the team, by the commands that made the changes
+// and completed the game. Games that aren't over count if the player is on the roster now, and games completed before this was kept always count
+func playedFor(changes []pb.RosterChange, g pb.Game) bool {
+	completed := g.Status == pb.Status_COMPLETED
+	if completed && g.FinishedCommand == 0 {
+		return true
+	}
+	on := len(changes) == 0 || !changes[0].Added // Players without an addition were on the team from the start
+	for _, c := range changes {
+		if completed && c.Command >= g.FinishedCommand {
+			break
+		}
+		on = c.Added
+	}
+	return on
+}
+
 func (t *tournament) NextRound() (models.Round, error) {
-	r := pb.Round{Status: pb.Status_NEW, TournamentId: t.Id}
-	err := t.Save(&r)
+	r := round{pb.Round{Status: pb.Status_NEW, TournamentId: t.Id}, t.store}
+	err := t.command("NextRound", func(t *tournament) error {
+		r.store = t.store
+		return t.Save(&r.Round)
+	})
 	if err != nil {
 		fmt.Println("Error starting a new round:", err)
 	}
-	return &round{r, t.DB}, err
+	return &r, err
+}
+
+// Record runs fn as a single command, so undo reverts all of it together. If fn fails, none of its changes are kept
+func (t *tournament) Record(name string, fn func(models.Tournament) error) error {
+	return t.command(name, func(t *tournament) error {
+		return fn(t)
+	})
 }
 
 func (t *tournament) getActiveRound() (*pb.Round, error) {
@@ -236,52 +295,108 @@ func (t *tournament) GetActiveRound() models.Round {
 	if err != nil {
 		return nil
 	}
-	return &round{*r, t.DB}
+	return &round{*r, t.store}
 }
 
 func (t *tournament) StartRound() {
-	r, err := t.getActiveRound()
-	if err != nil {
-		fmt.Println("Error getting active round:", err)
-		r = &pb.Round{TournamentId: t.Id}
-	}
-	r.Status = pb.Status_ONGOING
+	t.command("StartRound", func(t *tournament) error {
+		r, err := t.getActiveRound()
+		if err != nil {
+			fmt.Println("Error getting active round:", err)
+			r = &pb.Round{TournamentId: t.Id}
+		}
+		r.Status = pb.Status_ONGOING
 
-	err = t.Update(&r)
-	if err != nil {
-		fmt.Println("Error starting round:", err)
-	}
+		err = t.Update(&r)
+		if err != nil {
+			fmt.Println("Error starting round:", err)
+		}
+		return nil
+	})
 }
 
 func (t *tournament) GetAllRounds() []models.Round {
 	var rounds []models.Round
 	t.Select(q.Eq("TournamentId", t.Id)).Each(new(pb.Round), func(record interface{}) error {
 		r := record.(*pb.Round)
-		rounds = append(rounds, &round{*r, t.DB})
+		rounds = append(rounds, &round{*r, t.store})
 		return nil
 	})
 
 	return rounds
 }
 
+func (t *tournament) RemoveLastRound() error {
+	return t.command("RemoveLastRound", func(t *tournament) error {
+		return t.removeLastRound()
+	})
+}
+
+func (t *tournament) removeLastRound() error {
+	r, err := t.getActiveRound()
+	if err != nil {
+		return fmt.Errorf("No rounds to remove: %w", err)
+	}
+	var games []pb.Game
+	t.Select(q.Eq("RoundId", r.Id)).Find(&games)
+	for _, g := range games {
+		var subGames []pb.Game
+		t.Select(q.Eq("ParentId", g.Id)).Find(&subGames)
+		for _, sub := range subGames {
+			t.removeGame(sub)
+		}
+		t.removeGame(g)
+	}
+	err = t.DeleteStruct(r)
+	if err != nil {
+		return fmt.Errorf("Unable to remove round: %w", err)
+	}
+	if t.Status == pb.Status_COMPLETED { // The tournament isn't over without this round
+		t.SetStatus(models.Status_ONGOING)
+	}
+	return nil
+}
+
+// removeGame deletes a game and the teams that were playing in it
+func (t *tournament) removeGame(g pb.Game) {
+	var gts []pb.GameTeam
+	t.Select(q.Eq("GameId", g.Id)).Find(&gts)
+	for _, gt := range gts {
+		err := t.DeleteStruct(&gt)
+		if err != nil {
+			fmt.Println("Unable to remove team from game:", err)
+		}
+	}
+	err := t.DeleteStruct(&g)
+	if err != nil {
+		fmt.Println("Unable to remove game:", err)
+	}
+}
+
 func (t *tournament) GetType() models.TournamentType {
 	return models.TournamentType(t.Type)
 }
 
 func (t *tournament) SetMetadata(data []byte) {
-	t.Metadata = data
-	t.UpdateField(&t.Tournament, "Metadata", data)
+	t.command("SetMetadata", func(t *tournament) error {
+		t.Metadata = data
+		return t.UpdateField(&t.Tournament, "Metadata", data)
+	})
 }
 
 func (t *tournament) GetBracketOrder() []string {
 	return nil
 }
 
+func (t *tournament) DependsOnResults() bool {
+	return false
+}
+
 func (t *tournament) GetTeams() []models.Team {
 	var teams []models.Team
 	t.Select(q.Eq("TournamentId", t.Id)).Each(new(pb.Team), func(record interface{}) error {
 		t1 := record.(*pb.Team)
-		teams = append(teams, &team{*t1, t.DB})
+		teams = append(teams, &team{*t1, t.store})
 		return nil
 	})
 
@@ -291,7 +406,7 @@ func (t *tournament) GetTeams() []models.Team {
 func (t *tournament) GetTeam(name string) models.Team {
 	var tm pb.Team
 	t.Select(q.Eq("TournamentId", t.Id), q.Eq("Name", name)).First(&tm)
-	return &team{tm, t.DB}
+	return &team{tm, t.store}
 }
 
 func (t *tournament) IsScored() bool {
@@ -303,31 +418,69 @@ func (t *tournament) IsSeeded() bool {
 }
 
 func (t *tournament) SetStatus(status models.Status) {
-	t.Status = pb.Status(status)
-	t.UpdateField(&t.Tournament, "Status", pb.Status(status))
+	t.command("SetStatus", func(t *tournament) error {
+		t.Status = pb.Status(status)
+		return t.UpdateField(&t.Tournament, "Status", pb.Status(status))
+	})
 }
 
 func (t *tournament) SetFinal() {
-	// TODO: Mark active rounds and games as completed
-	t.Status = pb.Status_COMPLETED
-	t.UpdateField(&t.Tournament, "Status", pb.Status_COMPLETED)
+	t.command("SetFinal", func(t *tournament) error {
+		// TODO: Mark active rounds and games as completed
+		t.Status = pb.Status_COMPLETED
+		return t.UpdateField(&t.Tournament, "Status", pb.Status_COMPLETED)
+	})
 }
 
 func (t *tournament) CreateTeam(name string, players []models.Player, metadata []byte) models.Team {
-	tm := pb.Team{Name: name, TournamentId: t.Id, Metadata: metadata}
-	t.Save(&tm)
-	// Map all of the players to this new team
-	for _, p := range players {
-		var pbPlayer pb.Player
-		err := t.One("Name", p.GetName(), &pbPlayer)
+	created := &team{pb.Team{Name: name, TournamentId: t.Id, Metadata: metadata}, t.store}
+	t.command("CreateTeam", func(t *tournament) error {
+		created.store = t.store
+		t.Save(&created.Team)
+		// Map all of the players to this new team
+		for _, p := range players {
+			created.AddPlayer(p)
+		}
+		return nil
+	})
+
+	return created
+}
+
+func (t *tournament) Withdraw(tm models.Team) {
+	t.command("Withdraw", func(t *tournament) error {
+		var pbTeam pb.Team
+		err := t.Select(q.Eq("TournamentId", t.Id), q.Eq("Name", tm.GetName())).First(&pbTeam)
 		if err != nil {
+			fmt.Println("Unable to find team to withdraw:", err)
+			return nil
+		}
+		pbTeam.Withdrawn = true
+		t.UpdateField(&pbTeam, "Withdrawn", true)
 
+		withdrawn := &team{pbTeam, t.store}
+		for _, g := range withdrawn.GetRecords() {
+			if g.GetStatus() == models.Status_COMPLETED {
+				continue
+			}
+			g.Forfeit(withdrawn, "Withdrew from the tournament")
 		}
-		pt := pb.PlayerTeam{PlayerId: pbPlayer.Id, TeamId: tm.Id}
-		t.Save(&pt)
-	}
+		return nil
+	})
+}
 
-	return &team{tm, t.DB}
+func (t *tournament) SetForfeitScore(score int64) {
+	t.command("SetForfeitScore", func(t *tournament) error {
+		t.ForfeitScore = score
+		return t.UpdateField(&t.Tournament, "ForfeitScore", score)
+	})
+}
+
+func (t *tournament) SetRoundCount(rounds uint32) {
+	t.command("SetRoundCount", func(t *tournament) error {
+		t.RoundCount = rounds
+		return t.UpdateField(&t.Tournament, "RoundCount", rounds)
+	})
 }
 
 func (t *tournament) GetStatus() models.Status {
@@ -335,32 +488,43 @@ func (t *tournament) GetStatus() models.Status {
 }
 
 func (r *round) CreateGame(teams []models.Team, scored bool) models.Game {
-	g := pb.Game{RoundId: r.Id, Status: pb.Status_NEW}
-	r.Save(&g)
-
-	for _, t := range teams {
-		if models.IsByeTeam(t) {
-			continue
-		}
-		var pbTeam pb.Team
-		err := r.Select(q.Eq("Name", t.GetName()), q.Eq("TournamentId", r.GetTournamentId())).First(&pbTeam)
-		if err != nil {
-			fmt.Println("Error assigning teams for this game:", g)
+	created := &game{pb.Game{RoundId: r.Id, Status: pb.Status_NEW, SeriesLength: r.SeriesLength}, r.store}
+	r.command("CreateGame", func(r *round) error {
+		created.store = r.store
+		r.Save(&created.Game)
+
+		for _, t := range teams {
+			if models.IsByeTeam(t) {
+				continue
+			}
+			var pbTeam pb.Team
+			err := r.Select(q.Eq("Name", t.GetName()), q.Eq("TournamentId", r.GetTournamentId())).First(&pbTeam)
+			if err != nil {
+				fmt.Println("Error assigning teams for this game:", created.Game)
+			}
+			if t.Equals(&team{pbTeam, r.store}) {
+				gt := pb.GameTeam{GameId: created.Id, TeamId: pbTeam.Id}
+				r.Save(&gt)
+			}
 		}
-		if t.Equals(&team{pbTeam, r.DB}) {
-			gt := pb.GameTeam{GameId: g.Id, TeamId: pbTeam.Id}
-			r.Save(&gt)
+
+		// Teams that have withdrawn forfeit their games as they are created, so their opponents win by walkover
+		for _, t := range created.GetTeams() {
+			if t.IsWithdrawn() {
+				created.removeTeam(t, pb.Result_FORFEIT, "Withdrew from the tournament")
+			}
 		}
-	}
+		return nil
+	})
 
-	return &game{g, r.DB}
+	return created
 }
 
 func (r *round) GetGames() []models.Game {
 	var games []models.Game
 	err := r.Select(q.Eq("RoundId", r.Id)).Each(new(pb.Game), func(record interface{}) error {
 		g := record.(*pb.Game)
-		games = append(games, &game{*g, r.DB})
+		games = append(games, &game{*g, r.store})
 		return nil
 	})
 	if err != nil {
@@ -371,13 +535,17 @@ func (r *round) GetGames() []models.Game {
 }
 
 func (r *round) SetFinal() {
-	r.Status = pb.Status_COMPLETED
-	r.UpdateField(&r.Round, "Status", pb.Status_COMPLETED)
+	r.command("SetFinal", func(r *round) error {
+		r.Status = pb.Status_COMPLETED
+		return r.UpdateField(&r.Round, "Status", pb.Status_COMPLETED)
+	})
 }
 
 func (r *round) Start() {
-	r.Status = pb.Status_ONGOING
-	r.UpdateField(&r.Round, "Status", pb.Status_ONGOING)
+	r.command("Start", func(r *round) error {
+		r.Status = pb.Status_ONGOING
+		return r.UpdateField(&r.Round, "Status", pb.Status_ONGOING)
+	})
 }
 
 func (r *round) GetStatus() models.Status {
@@ -385,8 +553,42 @@ func (r *round) GetStatus() models.Status {
 }
 
 func (r *round) SetStatus(status models.Status) {
-	r.Status = pb.Status(status)
-	r.UpdateField(&r.Round, "Status", pb.Status(status))
+	r.command("SetStatus", func(r *round) error {
+		r.Status = pb.Status(status)
+		return r.UpdateField(&r.Round, "Status", pb.Status(status))
+	})
+}
+
+func (r *round) SetSeriesLength(length uint32) {
+	r.command("SetSeriesLength", func(r *round) error {
+		r.SeriesLength = length
+		err := r.UpdateField(&r.Round, "SeriesLength", length)
+		if err != nil {
+			fmt.Println("Unable to set series length:", err)
+		}
+		for _, g := range r.GetGames() {
+			if g.GetStatus() == models.Status_NEW {
+				g.SetSeriesLength(length)
+			}
+		}
+		return nil
+	})
+}
+
+func (r *round) Schedule(bookings []models.Booking) {
+	r.command("Schedule", func(r *round) error {
+		for _, b := range bookings {
+			g, ok := b.Game.(*game)
+			if !ok {
+				continue
+			}
+			g.join(r.store, func(g *game) error {
+				g.Schedule(b.Arena, b.Start, b.End)
+				return nil
+			})
+		}
+		return nil
+	})
 }
 
 func (g *game) GetTeams() []models.Team {
@@ -396,7 +598,7 @@ func (g *game) GetTeams() []models.Team {
 		var t pb.Team
 		// Grab them all in order
 		g.Select(q.Eq("Id", gt.TeamId)).First(&t)
-		teams = append(teams, &team{t, g.DB})
+		teams = append(teams, &team{t, g.store})
 		return nil
 	})
 
@@ -407,21 +609,62 @@ func (g *game) GetArena() models.Arena {
 	var a pb.Arena
 	g.One("Id", g.ArenaId, &a)
 
-	return &arena{a, g.DB}
+	return &arena{a, g.store}
 }
 
 func (g *game) SetArena(a models.Arena) {
-	var pbArena pb.Arena
-	g.One("Name", a.GetName(), &pbArena)
-	g.ArenaId = pbArena.Id
-	g.UpdateField(&g.Game, "ArenaId", pbArena.Id)
+	g.command("SetArena", func(g *game) error {
+		var pbArena pb.Arena
+		err := g.Select(q.Eq("CompetitionId", g.competitionId()), q.Eq("Name", a.GetName())).First(&pbArena)
+		if err != nil {
+			fmt.Println("No arena named", a.GetName(), "in the competition")
+			return nil
+		}
+		g.ArenaId = pbArena.Id
+		return g.UpdateField(&g.Game, "ArenaId", pbArena.Id)
+	})
+}
+
+func (g *game) Schedule(a models.Arena, start time.Time, end time.Time) {
+	g.command("Schedule", func(g *game) error {
+		g.SetArena(a)
+		g.StartTime = start.UnixNano()
+		g.EndTime = end.UnixNano()
+		err := g.UpdateField(&g.Game, "StartTime", g.StartTime)
+		if err == nil {
+			err = g.UpdateField(&g.Game, "EndTime", g.EndTime)
+		}
+		if err != nil {
+			fmt.Println("Unable to schedule game:", err)
+		}
+		return nil
+	})
+}
+
+func (g *game) GetSchedule() (time.Time, time.Time) {
+	if g.StartTime == 0 {
+		return time.Time{}, time.Time{}
+	}
+	return time.Unix(0, g.StartTime), time.Unix(0, g.EndTime)
 }
 
 func (g *game) SetScores(scores []int64) {
+	g.command("SetScores", func(g *game) error {
+		if g.Status == pb.Status_COMPLETED {
+			fmt.Println("Game is already final, its scores can only be changed with a correction")
+			return nil
+		}
+		g.setScores(scores)
+		return nil
+	})
+}
+
+func (g *game) setScores(scores []int64) {
 	var gts []pb.GameTeam
 	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
 	if len(scores) != len(gts) {
-		// Throw error
+		fmt.Printf("Expected %d scores, one for each team, got %d\n", len(gts), len(scores))
+		return
 	}
 
 	for i, gt := range gts {
@@ -431,7 +674,73 @@ func (g *game) SetScores(scores []int64) {
 
 }
 
+func (g *game) SetPeriods(periods models.Periods) error {
+	return g.command("SetPeriods", func(g *game) error {
+		return g.setPeriods(periods)
+	})
+}
+
+func (g *game) setPeriods(periods models.Periods) error {
+	if g.Status == pb.Status_COMPLETED {
+		return fmt.Errorf("Game is already final, its scores can only be changed with a correction")
+	}
+	var gts []pb.GameTeam
+	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+	for i, period := range periods.Scores {
+		if len(period) != len(gts) {
+			return fmt.Errorf("Period %s has %d scores, expected one for each of the %d teams", periods.Label(i), len(period), len(gts))
+		}
+	}
+
+	g.PeriodType = pb.PeriodType(periods.Type)
+	g.RegulationPeriods = uint32(periods.Regulation)
+	g.UpdateField(&g.Game, "PeriodType", g.PeriodType)
+	g.UpdateField(&g.Game, "RegulationPeriods", g.RegulationPeriods)
+
+	for i, gt := range gts {
+		gt.PeriodScores = periods.TeamScores(i)
+		err := g.UpdateField(&gt, "PeriodScores", gt.PeriodScores)
+		if err != nil {
+			return fmt.Errorf("Unable to update period scores: %w", err)
+		}
+	}
+
+	totals := periods.Totals()
+	if len(totals) == 0 { // No periods have been played
+		totals = make([]int64, len(gts))
+	}
+	g.setScores(totals)
+	return nil
+}
+
+func (g *game) GetPeriods() models.Periods {
+	periods := models.Periods{Type: models.PeriodType(g.PeriodType), Regulation: int(g.RegulationPeriods)}
+	var gts []pb.GameTeam
+	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+	for i, gt := range gts {
+		for j, score := range gt.PeriodScores {
+			for len(periods.Scores) <= j {
+				periods.Scores = append(periods.Scores, make([]int64, len(gts)))
+			}
+			periods.Scores[j][i] = score
+		}
+	}
+
+	return periods
+}
+
 func (g *game) SetPlaces(places []int64) {
+	g.command("SetPlaces", func(g *game) error {
+		if g.Status == pb.Status_COMPLETED {
+			fmt.Println("Game is already final, its places can only be changed with a correction")
+			return nil
+		}
+		g.setPlaces(places)
+		return nil
+	})
+}
+
+func (g *game) setPlaces(places []int64) {
 	var gts []pb.GameTeam
 	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
 	if len(places) != len(gts) {
@@ -448,6 +757,51 @@ func (g *game) SetPlaces(places []int64) {
 	}
 }
 
+func (g *game) Correct(official string, scores []int64, places []int64) error {
+	return g.command("Correct", func(g *game) error {
+		return g.correct(official, scores, places)
+	})
+}
+
+func (g *game) correct(official string, scores []int64, places []int64) error {
+	if g.Status != pb.Status_COMPLETED {
+		return fmt.Errorf("Game isn't final, set its result instead of correcting it")
+	}
+	c := pb.Correction{GameId: g.Id, Official: official, Timestamp: time.Now().UnixNano(), OldScores: g.GetScores(), OldPlaces: g.GetPlaces()}
+	if scores != nil {
+		g.setScores(scores)
+	}
+	if places != nil {
+		g.setPlaces(places)
+	}
+	g.finalize() // Re-rank scored games, and settle the results again
+	c.NewScores = g.GetScores()
+	c.NewPlaces = g.GetPlaces()
+
+	err := g.Save(&c)
+	if err != nil {
+		return fmt.Errorf("Unable to record correction: %w", err)
+	}
+	return nil
+}
+
+func (g *game) GetCorrections() []models.Correction {
+	var corrections []models.Correction
+	g.Select(q.Eq("GameId", g.Id)).Each(new(pb.Correction), func(record interface{}) error {
+		c := record.(*pb.Correction)
+		corrections = append(corrections, models.Correction{
+			Official:  c.Official,
+			Time:      time.Unix(0, c.Timestamp),
+			OldScores: c.OldScores,
+			NewScores: c.NewScores,
+			OldPlaces: c.OldPlaces,
+			NewPlaces: c.NewPlaces,
+		})
+		return nil
+	})
+	return corrections
+}
+
 func (g *game) GetScores() []int64 {
 	var scores []int64
 	g.Select(q.Eq("GameId", g.Id)).Each(new(pb.GameTeam), func(record interface{}) error {
@@ -471,16 +825,21 @@ func (g *game) GetPlaces() []int64 {
 }
 
 func (g *game) Start() {
-	g.Status = pb.Status_ONGOING
-	g.UpdateField(g.Game, "Status", pb.Status_ONGOING)
+	g.command("Start", func(g *game) error {
+		g.Status = pb.Status_ONGOING
+		return g.UpdateField(&g.Game, "Status", pb.Status_ONGOING)
+	})
 }
 
 func (g *game) SetStatus(status models.Status) {
-	g.Status = pb.Status(status)
-	err := g.UpdateField(&g.Game, "Status", pb.Status(status))
-	if err != nil {
-		fmt.Println("Error updating game status:", err)
-	}
+	g.command("SetStatus", func(g *game) error {
+		g.Status = pb.Status(status)
+		err := g.UpdateField(&g.Game, "Status", pb.Status(status))
+		if err != nil {
+			fmt.Println("Error updating game status:", err)
+		}
+		return err
+	})
 }
 
 func (g *game) GetStatus() models.Status {
@@ -488,26 +847,39 @@ func (g *game) GetStatus() models.Status {
 }
 
 func (g *game) IsScored() bool {
+	return g.getTournament().Scored
+}
+
+// getTournament looks up the tournament the game is being played in
+func (g *game) getTournament() pb.Tournament {
+	roundId := g.RoundId
+	if g.ParentId != 0 { // Sub-games of a series belong to the same tournament as their series
+		var parent pb.Game
+		g.One("Id", g.ParentId, &parent)
+		roundId = parent.RoundId
+	}
 	var r round
-	g.One("Id", g.RoundId, &r.Round)
+	g.One("Id", roundId, &r.Round)
 	var t tournament
 	g.One("Id", r.TournamentId, &t.Tournament)
-	return t.Scored
+	return t.Tournament
 }
 
 func (g *game) SetBracket(bracket string) {
-	g.Bracket = bracket
-	err := g.UpdateField(&g.Game, "Bracket", bracket)
-	if err != nil {
-		fmt.Println("Unable to set bracket:", err)
-	}
-	return
+	g.command("SetBracket", func(g *game) error {
+		g.Bracket = bracket
+		err := g.UpdateField(&g.Game, "Bracket", bracket)
+		if err != nil {
+			fmt.Println("Unable to set bracket:", err)
+		}
+		return err
+	})
 }
 
 type teamScore struct {
-	teamId uint64
-	score  int64
-	place  int64
+	index int // Position of the team within the game
+	score int64
+	place int64
 }
 
 type teamScores []teamScore
@@ -517,8 +889,21 @@ func (t teamScores) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
 func (t teamScores) Less(i, j int) bool { return t[i].score < t[j].score }
 
 func (g *game) SetFinal() {
+	g.command("SetFinal", func(g *game) error {
+		g.finalize()
+		return nil
+	})
+}
+
+// finalize completes the game, placing the teams by their scores if it is scored and settling how the game ended for each team
+func (g *game) finalize() {
+	finishing := g.Status != pb.Status_COMPLETED
 	g.Status = pb.Status_COMPLETED
 	g.UpdateField(&g.Game, "Status", pb.Status_COMPLETED)
+	if finishing {
+		g.FinishedCommand = g.current.id
+		g.UpdateField(&g.Game, "FinishedCommand", g.FinishedCommand)
+	}
 
 	// If the game is scored, update places
 	if g.IsScored() {
@@ -529,45 +914,248 @@ func (g *game) SetFinal() {
 			// Throw error
 		}
 
-		allScores := teamScores{}
-		places := make([]int64, len(scores))
-		scoresMap := map[int64]int{} // Map scores to how many teams who scored that score
-		teamIndex := map[uint64]int{}
-
-		for i, gt := range gts {
-			gt.Score = scores[i]
-			scoresMap[scores[i]]++
-			allScores = append(allScores, teamScore{gt.TeamId, gt.Score, 0})
-			teamIndex[gt.TeamId] = i
-		}
-
-		sort.Sort(sort.Reverse(allScores))
-
-		lastPlace := -1
-		for _, place := range allScores {
-			if scoresMap[place.score] > 1 {
-				// There was a tie
-				p := lastPlace
-				if lastPlace < 0 {
-					p *= -1
-				}
-				place.place = int64(p * -1)
-			} else {
-				lastPlace++
-				place.place = int64(lastPlace)
-			}
-			places[teamIndex[place.teamId]] = place.place
-		}
-
+		places := rankScores(scores)
 		for i, gt := range gts {
 			gt.Place = places[i]
 			g.UpdateField(&gt, "Place", places[i])
 		}
 	}
+	g.settleResults()
+
+	if g.ParentId != 0 {
+		g.updateSeries()
+	}
+
+	// The arena is free for the next game in its queue
+	if finishing && g.ParentId == 0 && g.ArenaId != 0 {
+		g.losersRef() // Before the next game is started, so the loser refs the game that follows on straight away
+		g.advanceQueue()
+	}
 
 	return
 }
 
+// rankScores converts scores into places, with the highest score placing first. Teams that tied are given a negative place
+func rankScores(scores []int64) []int64 {
+	allScores := teamScores{}
+	places := make([]int64, len(scores))
+	scoresMap := map[int64]int{} // Map scores to how many teams who scored that score
+
+	for i, score := range scores {
+		scoresMap[score]++
+		allScores = append(allScores, teamScore{i, score, 0})
+	}
+
+	sort.Sort(sort.Reverse(allScores))
+
+	lastPlace := -1
+	for _, place := range allScores {
+		if scoresMap[place.score] > 1 {
+			// There was a tie
+			p := lastPlace
+			if lastPlace < 0 {
+				p *= -1
+			}
+			place.place = int64(p * -1)
+		} else {
+			lastPlace++
+			place.place = int64(lastPlace)
+		}
+		places[place.index] = place.place
+	}
+
+	return places
+}
+
+func (g *game) SetSeriesLength(length uint32) {
+	g.command("SetSeriesLength", func(g *game) error {
+		g.SeriesLength = length
+		err := g.UpdateField(&g.Game, "SeriesLength", length)
+		if err != nil {
+			fmt.Println("Unable to set series length:", err)
+		}
+		return err
+	})
+}
+
+func (g *game) GetSeries() models.Series {
+	if g.SeriesLength <= 1 {
+		return nil
+	}
+	return &series{g}
+}
+
+func (g *game) Forfeit(t models.Team, reason string) {
+	g.command("Forfeit", func(g *game) error {
+		if g.Status == pb.Status_COMPLETED {
+			fmt.Println("Game is already final, its result can only be changed with a correction")
+			return nil
+		}
+		g.removeTeam(t, pb.Result_FORFEIT, reason)
+		return nil
+	})
+}
+
+func (g *game) ForfeitAll(reason string) {
+	g.command("ForfeitAll", func(g *game) error {
+		if g.Status == pb.Status_COMPLETED {
+			fmt.Println("Game is already final, its result can only be changed with a correction")
+			return nil
+		}
+		var gts []pb.GameTeam
+		g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+		for _, gt := range gts {
+			if isRemoved(gt.Result) {
+				continue
+			}
+			g.UpdateField(&gt, "Result", pb.Result_FORFEIT)
+			g.UpdateField(&gt, "Reason", reason)
+			g.UpdateField(&gt, "Score", int64(0))
+		}
+		g.finalize()
+		return nil
+	})
+}
+
+func (g *game) Disqualify(t models.Team, reason string) {
+	g.command("Disqualify", func(g *game) error {
+		if g.Status == pb.Status_COMPLETED {
+			fmt.Println("Game is already final, its result can only be changed with a correction")
+			return nil
+		}
+		g.removeTeam(t, pb.Result_DISQUALIFIED, reason)
+		return nil
+	})
+}
+
+// removeTeam takes a team out of the game. Once only a single team remains, it wins by walkover and the game is completed
+func (g *game) removeTeam(t models.Team, result pb.Result, reason string) {
+	tActual, ok := t.(*team)
+	if !ok {
+		return
+	}
+	var gts []pb.GameTeam
+	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+
+	remaining := []pb.GameTeam{}
+	for _, gt := range gts {
+		if gt.TeamId == tActual.Id {
+			gt.Result = result
+			gt.Reason = reason
+			gt.Score = 0
+			g.UpdateField(&gt, "Result", result)
+			g.UpdateField(&gt, "Reason", reason)
+			g.UpdateField(&gt, "Score", int64(0))
+			continue
+		}
+		if !isRemoved(gt.Result) {
+			remaining = append(remaining, gt)
+		}
+	}
+
+	if len(remaining) > 1 {
+		return
+	}
+	forfeitScore := g.getTournament().ForfeitScore
+	for _, gt := range remaining {
+		gt.Result = pb.Result_WALKOVER
+		g.UpdateField(&gt, "Result", pb.Result_WALKOVER)
+		if gt.Score < forfeitScore {
+			gt.Score = forfeitScore
+			g.UpdateField(&gt, "Score", forfeitScore)
+		}
+	}
+	g.finalize()
+}
+
+// settleResults decides how the game ended for each team. Teams that forfeited or were disqualified are placed behind every team that finished the game
+func (g *game) settleResults() {
+	var gts []pb.GameTeam
+	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+
+	finished := 0
+	for _, gt := range gts {
+		if !isRemoved(gt.Result) {
+			finished++
+		}
+	}
+	removed := finished
+	scored := g.IsScored()
+	for _, gt := range gts {
+		if isRemoved(gt.Result) {
+			gt.Place = int64(removed)
+			g.UpdateField(&gt, "Place", gt.Place)
+			removed++
+			continue
+		}
+		if gt.Result == pb.Result_WALKOVER {
+			if gt.Place != 0 {
+				gt.Place = 0
+				g.UpdateField(&gt, "Place", int64(0))
+			}
+			continue
+		}
+
+		result := pb.Result_LOSS
+		winning, tied := true, false
+		for _, other := range gts {
+			if other.Id == gt.Id || isRemoved(other.Result) {
+				continue
+			}
+			if scored {
+				winning = winning && gt.Score >= other.Score
+				tied = tied || gt.Score == other.Score
+			} else {
+				winning = winning && gt.Place <= 0 && other.Place != 0
+				tied = tied || gt.Place == other.Place
+			}
+		}
+		if winning && tied {
+			result = pb.Result_DRAW
+		} else if winning {
+			result = pb.Result_WIN
+		}
+		if finished == 1 {
+			result = pb.Result_WALKOVER
+			if len(gts) == 1 && !scored && gt.Place < 0 {
+				result = pb.Result_DRAW // A bye worth half a win
+			}
+		}
+		gt.Result = result
+		g.UpdateField(&gt, "Result", result)
+	}
+}
+
+// isRemoved determines if a result took the team out of the game early
+func isRemoved(result pb.Result) bool {
+	return result == pb.Result_FORFEIT || result == pb.Result_DISQUALIFIED
+}
+
+func (g *game) GetResults() []models.Result {
+	var results []models.Result
+	g.Select(q.Eq("GameId", g.Id)).Each(new(pb.GameTeam), func(record interface{}) error {
+		gt := record.(*pb.GameTeam)
+		results = append(results, models.Result(gt.Result))
+		return nil
+	})
+
+	return results
+}
+
+func (g *game) GetTeamResult(t models.Team) (models.Result, string) {
+	tActual, ok := t.(*team)
+	if !ok {
+		return models.Result_UNDECIDED, ""
+	}
+	var gt pb.GameTeam
+	err := g.Select(q.Eq("GameId", g.Id), q.Eq("TeamId", tActual.Id)).First(&gt)
+	if err != nil {
+		return models.Result_UNDECIDED, ""
+	}
+
+	return models.Result(gt.Result), gt.Reason
+}
+
 func (g *game) GetTeamPlace(t models.Team) int64 {
 	tActual, ok := t.(*team)
 	if !ok {
@@ -606,7 +1194,7 @@ func (t *team) Equals(t2 models.Team) bool {
 
 func (t *team) GetPlayers() []models.Player {
 	var playerIds []uint64
-	t.Select(q.Eq("TeamId", t.Id)).Each(new(pb.PlayerTeam), func(record interface{}) error {
+	t.Select(q.Eq("TeamId", t.Id), q.Eq("Removed", false)).Each(new(pb.PlayerTeam), func(record interface{}) error {
 		pt := record.(*pb.PlayerTeam)
 		playerIds = append(playerIds, pt.PlayerId)
 		return nil
@@ -614,7 +1202,7 @@ func (t *team) GetPlayers() []models.Player {
 	var players []models.Player
 	t.Select(q.In("Id", playerIds)).Each(new(pb.Player), func(record interface{}) error {
 		p := record.(*pb.Player)
-		players = append(players, &player{*p, t.DB})
+		players = append(players, &player{*p, t.store})
 		return nil
 	})
 	return players
@@ -628,22 +1216,112 @@ func (t *team) GetRecords() []models.Game {
 		gameIds = append(gameIds, gt.GameId)
 		return nil
 	})
-	t.Select(q.In("Id", gameIds)).Each(new(pb.Game), func(record interface{}) error {
+	t.Select(q.In("Id", gameIds), q.Eq("ParentId", uint64(0))).Each(new(pb.Game), func(record interface{}) error {
 		g := record.(*pb.Game)
-		games = append(games, &game{*g, t.DB})
+		games = append(games, &game{*g, t.store})
 		return nil
 	})
 
 	return games
 }
 
+func (t *team) IsWithdrawn() bool {
+	return t.Withdrawn
+}
+
+func (t *team) AddPlayer(p models.Player) {
+	t.command("AddPlayer", func(t *team) error {
+		t.addPlayer(p)
+		return nil
+	})
+}
+
+func (t *team) addPlayer(p models.Player) {
+	var pbPlayer pb.Player
+	err := t.One("Name", p.GetName(), &pbPlayer)
+	if err != nil {
+		fmt.Println("Unable to find player to add:", err)
+		return
+	}
+	var pt pb.PlayerTeam
+	err = t.Select(q.Eq("TeamId", t.Id), q.Eq("PlayerId", pbPlayer.Id)).First(&pt)
+	switch {
+	case err == storm.ErrNotFound:
+		pt = pb.PlayerTeam{PlayerId: pbPlayer.Id, TeamId: t.Id}
+		t.Save(&pt)
+	case err != nil:
+		fmt.Println("Unable to add player:", err)
+		return
+	case pt.Removed: // Returning to the team
+		pt.Removed = false
+		t.UpdateField(&pt, "Removed", false)
+	default: // Already on the roster
+		return
+	}
+	t.logRosterChange(pbPlayer.Id, true)
+}
+
+func (t *team) RemovePlayer(p models.Player) {
+	t.command("RemovePlayer", func(t *team) error {
+		t.removePlayer(p)
+		return nil
+	})
+}
+
+func (t *team) removePlayer(p models.Player) {
+	var pbPlayer pb.Player
+	err := t.One("Name", p.GetName(), &pbPlayer)
+	if err != nil {
+		fmt.Println("Unable to find player to remove:", err)
+		return
+	}
+	var pt pb.PlayerTeam
+	err = t.Select(q.Eq("TeamId", t.Id), q.Eq("PlayerId", pbPlayer.Id), q.Eq("Removed", false)).First(&pt)
+	if err != nil {
+		return
+	}
+	// The mapping is kept so the player's records still include the games they played for this team
+	pt.Removed = true
+	t.UpdateField(&pt, "Removed", true)
+	t.logRosterChange(pbPlayer.Id, false)
+}
+
+func (t *team) SubstitutePlayer(out models.Player, in models.Player) {
+	t.command("SubstitutePlayer", func(t *team) error {
+		t.removePlayer(out)
+		t.addPlayer(in)
+		return nil
+	})
+}
+
+func (t *team) GetRosterChanges() []models.RosterChange {
+	var changes []models.RosterChange
+	t.Select(q.Eq("TeamId", t.Id)).OrderBy("Id").Each(new(pb.RosterChange), func(record interface{}) error {
+		rc := record.(*pb.RosterChange)
+		var p pb.Player
+		if err := t.One("Id", rc.PlayerId, &p); err != nil {
+			return nil
+		}
+		changes = append(changes, models.RosterChange{Player: &player{p, t.store}, Added: rc.Added, Time: time.Unix(0, rc.Timestamp)})
+		return nil
+	})
+	return changes
+}
+
+func (t *team) logRosterChange(playerId uint64, added bool) {
+	rc := pb.RosterChange{TeamId: t.Id, PlayerId: playerId, Added: added, Timestamp: time.Now().UnixNano(), Command: t.current.id}
+	t.Save(&rc)
+}
+
 func (t *team) IsBye() bool {
 	return len(t.GetPlayers()) == 0
 }
 
 func (t *team) SetMetadata(data []byte) {
-	t.Metadata = data
-	t.UpdateField(&t.Team, "Metadata", data)
+	t.command("SetMetadata", func(t *team) error {
+		t.Metadata = data
+		return t.UpdateField(&t.Team, "Metadata", data)
+	})
 }
 
 func CreateByeTeam() models.Team {
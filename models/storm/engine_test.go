@@ -0,0 +1,169 @@
+package storm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+)
+
+// newTestEngine opens a storage engine in a fresh database that is removed once the test is done
+func newTestEngine(t *testing.T) models.StorageEngine {
+	dir, err := ioutil.TempDir("", "competition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	e, err := NewStorageEngine(filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+// newTestTournament creates a competition with an unseeded tournament of single player teams
+func newTestTournament(t *testing.T, e models.StorageEngine, name string, tournamentType models.TournamentType, teamCount int, scored bool) (models.Competition, models.Tournament) {
+	c := e.CreateCompetition(name, nil)
+	tourney := c.AddTournament(name, tournamentType, nil, false, 2, 1, scored)
+	for i := 1; i <= teamCount; i++ {
+		player := e.CreatePlayer(fmt.Sprintf("%s %d", name, i), nil)
+		tourney.CreateTeam(player.GetName(), []models.Player{player}, nil)
+	}
+	return c, tourney
+}
+
+func TestForfeitFinalGame(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := tourney.GetTeams()
+	game := round.CreateGame(teams, true)
+	game.SetScores([]int64{3, 1})
+	game.SetFinal()
+
+	game.Forfeit(teams[0], "Late")
+	game.Disqualify(teams[0], "Ineligible")
+	game = tourney.GetActiveRound().GetGames()[0]
+	if results := game.GetResults(); results[0] != models.Result_WIN || results[1] != models.Result_LOSS {
+		t.Errorf("Results of a final game changed to %v", results)
+	}
+	if scores := game.GetScores(); scores[0] != 3 || scores[1] != 1 {
+		t.Errorf("Scores of a final game changed to %v", scores)
+	}
+}
+
+func TestForfeitAll(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := round.CreateGame(tourney.GetTeams(), true)
+	game.ForfeitAll("No show")
+
+	game = tourney.GetActiveRound().GetGames()[0]
+	if game.GetStatus() != models.Status_COMPLETED {
+		t.Errorf("Game isn't over after every team forfeited")
+	}
+	for i, result := range game.GetResults() {
+		if result != models.Result_FORFEIT {
+			t.Errorf("Team %d has result %v, expected a forfeit", i+1, result)
+		}
+	}
+}
+
+func TestSetPeriods(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := round.CreateGame(tourney.GetTeams(), true)
+
+	if err := game.SetPeriods(models.Periods{Scores: [][]int64{{1}}}); err == nil {
+		t.Errorf("Expected an error for a period without a score for each team")
+	}
+	if err := game.SetPeriods(models.Periods{Scores: [][]int64{{1, 0}, {2, 1, 4}}}); err == nil {
+		t.Errorf("Expected an error for a period with too many scores")
+	}
+
+	periods := models.Periods{Type: models.PeriodType_SETS, Regulation: 3, Scores: [][]int64{{6, 4}, {3, 6}, {7, 6}}}
+	if err := game.SetPeriods(periods); err != nil {
+		t.Fatal(err)
+	}
+	game = tourney.GetActiveRound().GetGames()[0]
+	if scores := game.GetScores(); scores[0] != 2 || scores[1] != 1 {
+		t.Errorf("Scores are %v, expected sets won [2 1]", scores)
+	}
+	stored := game.GetPeriods()
+	if stored.Type != models.PeriodType_SETS || stored.Regulation != 3 || len(stored.Scores) != 3 || stored.Scores[2][1] != 6 {
+		t.Errorf("Stored periods are %+v, expected %+v", stored, periods)
+	}
+
+	game.SetFinal()
+	if err := game.SetPeriods(models.Periods{Scores: [][]int64{{1, 0}}}); err == nil {
+		t.Errorf("Expected an error setting the periods of a final game")
+	}
+}
+
+func TestRecordsFollowRoster(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	teams := tourney.GetTeams()
+	playGame := func() {
+		round, err := tourney.NextRound()
+		if err != nil {
+			t.Fatal(err)
+		}
+		game := round.CreateGame(teams, true)
+		game.SetScores([]int64{2, 1})
+		game.SetFinal()
+		round.SetFinal()
+	}
+
+	playGame()
+	sub := e.CreatePlayer("Sub", nil)
+	teams[0].SubstitutePlayer(e.GetPlayer("A 1"), sub)
+	playGame()
+
+	for name, expected := range map[string]int{"A 1": 1, "Sub": 1, "A 2": 2} {
+		p := e.GetPlayer(name)
+		if played := len(p.GetRecords()); played != expected {
+			t.Errorf("%s has %d games in their records, expected %d", name, played, expected)
+		}
+		if played := p.GetStats().Total.Played; played != expected {
+			t.Errorf("%s has played %d games in their stats, expected %d", name, played, expected)
+		}
+	}
+	for _, name := range []string{"A 1", "Sub"} {
+		if m := models.PlayerHeadToHead(e.GetPlayer(name), e.GetPlayer("A 2")); m.Played != 1 || m.Wins != 1 {
+			t.Errorf("%s played A 2 %d times with %d wins, expected a single win", name, m.Played, m.Wins)
+		}
+	}
+}
+
+func TestPlayedForOrdersByCommand(t *testing.T) {
+	// The clock can't tell apart changes made in the same instant, the commands that made them can
+	g := pb.Game{Status: pb.Status_COMPLETED, FinishedCommand: 5}
+	changes := []pb.RosterChange{{Added: false, Timestamp: 100, Command: 4}, {Added: true, Timestamp: 100, Command: 6}}
+	if playedFor(changes, g) {
+		t.Errorf("Player removed before the game was completed played in it")
+	}
+	changes = []pb.RosterChange{{Added: true, Timestamp: 100, Command: 4}, {Added: false, Timestamp: 100, Command: 6}}
+	if !playedFor(changes, g) {
+		t.Errorf("Player removed after the game was completed didn't play in it")
+	}
+	g.Status = pb.Status_ONGOING
+	if playedFor(changes, g) {
+		t.Errorf("Player who has been removed plays in a game that isn't over")
+	}
+}
@@ -0,0 +1,191 @@
+package storm
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/codec/protobuf"
+)
+
+// store wraps the storm database, every change made through it is appended to the event log.
+// Changes can only be made through the copy of the store a command is recorded with, see withCommand
+type store struct {
+	storm.Node
+	commands    *sync.Mutex  // Shared by every copy of the store, one command is recorded at a time
+	current     *command     // Command being recorded through this copy of the store, nil if it isn't recording one
+	subscribers *subscribers // Shared by every copy of the store, so they outlive the command being recorded
+}
+
+// errNoCommand is returned for changes made outside of a command, which couldn't be undone
+var errNoCommand = errors.New("Changes can only be made as part of a command")
+
+func newStore(db *storm.DB) *store {
+	return &store{Node: db, commands: &sync.Mutex{}, subscribers: &subscribers{fns: map[int]func(models.Change){}}}
+}
+
+// eventKinds creates an empty record for each kind of record that can show up in the event log
+var eventKinds = map[string]func() interface{}{
+	"Competition":  func() interface{} { return new(pb.Competition) },
+	"Tournament":   func() interface{} { return new(pb.Tournament) },
+	"Arena":        func() interface{} { return new(pb.Arena) },
+	"Player":       func() interface{} { return new(pb.Player) },
+	"Team":         func() interface{} { return new(pb.Team) },
+	"PlayerTeam":   func() interface{} { return new(pb.PlayerTeam) },
+	"RosterChange": func() interface{} { return new(pb.RosterChange) },
+	"Round":        func() interface{} { return new(pb.Round) },
+	"Game":         func() interface{} { return new(pb.Game) },
+	"GameTeam":     func() interface{} { return new(pb.GameTeam) },
+	"Correction":   func() interface{} { return new(pb.Correction) },
+	"Official":     func() interface{} { return new(pb.Official) },
+	"GameOfficial": func() interface{} { return new(pb.GameOfficial) },
+	"Command":      func() interface{} { return new(pb.Command) },
+}
+
+func (s *store) Save(data interface{}) error {
+	if !s.recording() {
+		return errNoCommand
+	}
+	action := pb.EventAction_UPDATED
+	if recordId(data) == 0 {
+		action = pb.EventAction_CREATED
+	}
+	err := s.Node.Save(data)
+	if err != nil {
+		return err
+	}
+	return s.logEvent(action, data, "")
+}
+
+func (s *store) Update(data interface{}) error {
+	if !s.recording() {
+		return errNoCommand
+	}
+	err := s.Node.Update(data)
+	if err != nil {
+		return err
+	}
+	return s.logEvent(pb.EventAction_UPDATED, s.reload(data), "")
+}
+
+func (s *store) UpdateField(data interface{}, fieldName string, value interface{}) error {
+	if !s.recording() {
+		return errNoCommand
+	}
+	err := s.Node.UpdateField(data, fieldName, value)
+	if err != nil {
+		return err
+	}
+	return s.logEvent(pb.EventAction_UPDATED, s.reload(data), fieldName)
+}
+
+func (s *store) DeleteStruct(data interface{}) error {
+	if !s.recording() {
+		return errNoCommand
+	}
+	err := s.Node.DeleteStruct(data)
+	if err != nil {
+		return err
+	}
+	return s.logEvent(pb.EventAction_DELETED, data, "")
+}
+
+// reload reads the whole record back from the database, so the event holds all of the record and not just the fields that were changed
+func (s *store) reload(data interface{}) interface{} {
+	kind := reflect.TypeOf(data).Elem().Name()
+	newRecord, ok := eventKinds[kind]
+	if !ok {
+		return data
+	}
+	record := newRecord()
+	err := s.Node.One("Id", reflect.ValueOf(data).Elem().FieldByName("Id").Interface(), record)
+	if err != nil {
+		return data
+	}
+	return record
+}
+
+// logEvent appends a change to the event log, as part of the command being recorded
+func (s *store) logEvent(action pb.EventAction, data interface{}, field string) error {
+	kind := reflect.TypeOf(data).Elem().Name()
+	if _, ok := eventKinds[kind]; !ok {
+		return nil
+	}
+	encoded, err := s.Codec().Marshal(data)
+	if err != nil {
+		return fmt.Errorf("Unable to encode event: %w", err)
+	}
+	e := pb.Event{Action: action, Kind: kind, RecordId: recordId(data), Field: field, Data: encoded, Timestamp: time.Now().UnixNano(), Command: s.current.id}
+	err = s.Node.Save(&e)
+	if err != nil {
+		return fmt.Errorf("Unable to log event: %w", err)
+	}
+	s.noteChange(action, data, field)
+	return nil
+}
+
+// recordId returns the id of a stored record, 0 if it hasn't been saved yet
+func recordId(data interface{}) uint64 {
+	id := reflect.ValueOf(data).Elem().FieldByName("Id")
+	switch id.Kind() {
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return id.Uint()
+	}
+	return 0
+}
+
+func (e *engine) GetEvents() []models.Event {
+	var events []models.Event
+	e.Node.Select().Each(new(pb.Event), func(record interface{}) error {
+		ev := record.(*pb.Event)
+		events = append(events, models.Event{
+			Id:       ev.Id,
+			Time:     time.Unix(0, ev.Timestamp),
+			Action:   models.EventAction(ev.Action),
+			Kind:     ev.Kind,
+			RecordId: ev.RecordId,
+			Field:    ev.Field,
+			Command:  ev.Command,
+			Data:     ev.Data,
+		})
+		return nil
+	})
+	return events
+}
+
+// RebuildStorageEngine creates a new storm backed StorageEngine at path, by replaying the events in order.
+// Passing only the events up to a point in time gives the state of the competitions at that time
+func RebuildStorageEngine(path string, events []models.Event) (models.StorageEngine, error) {
+	db, err := storm.Open(path, storm.Codec(protobuf.Codec))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open storage engine: %w", err)
+	}
+
+	for _, ev := range events {
+		record, err := decodeEvent(db, ev.Kind, ev.Data)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to replay event %d: %w", ev.Id, err)
+		}
+		if ev.Action == models.EventAction_DELETED {
+			err = db.DeleteStruct(record)
+		} else {
+			err = db.Save(record)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Unable to replay event %d: %w", ev.Id, err)
+		}
+		logged := pb.Event{Action: pb.EventAction(ev.Action), Kind: ev.Kind, RecordId: ev.RecordId, Field: ev.Field, Data: ev.Data, Timestamp: ev.Time.UnixNano(), Command: ev.Command}
+		err = db.Save(&logged)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to log event %d: %w", ev.Id, err)
+		}
+	}
+
+	return &engine{newStore(db)}, nil
+}
@@ -0,0 +1,141 @@
+package storm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+)
+
+func TestConcurrentCommands(t *testing.T) {
+	e := newTestEngine(t)
+	competitions := []models.Competition{}
+	games := []models.Game{}
+	for _, name := range []string{"A", "B", "C"} {
+		c, tourney := newTestTournament(t, e, name, models.TournamentType_ROUND_ROBIN, 2, true)
+		round, err := tourney.NextRound()
+		if err != nil {
+			t.Fatal(err)
+		}
+		competitions = append(competitions, c)
+		games = append(games, round.CreateGame(tourney.GetTeams(), true))
+	}
+
+	var changes sync.WaitGroup
+	cancel := e.Subscribe(func(models.Change) { changes.Done() })
+	const updates = 20
+	changes.Add(len(games) * updates)
+
+	var wg sync.WaitGroup
+	for _, g := range games {
+		wg.Add(1)
+		go func(g models.Game) {
+			defer wg.Done()
+			for i := 1; i <= updates; i++ {
+				g.SetScores([]int64{int64(i), 0})
+			}
+		}(g)
+	}
+	wg.Wait()
+	changes.Wait()
+	cancel()
+
+	// Each command only holds the changes it made, so undo puts back the score before the last one in every competition
+	for i, c := range competitions {
+		if err := c.Undo(); err != nil {
+			t.Fatal(err)
+		}
+		game := c.GetAllTournaments()[0].GetActiveRound().GetGames()[0]
+		if scores := game.GetScores(); scores[0] != updates-1 {
+			t.Errorf("Competition %d has scores %v after undo, expected %d", i+1, scores, updates-1)
+		}
+	}
+}
+
+func TestChangesNeedACommand(t *testing.T) {
+	e := newTestEngine(t).(*engine)
+	if err := e.Save(&pb.Arena{Name: "Court 1"}); err != errNoCommand {
+		t.Errorf("Saving outside of a command returned %v, expected %v", err, errNoCommand)
+	}
+	if events := e.GetEvents(); len(events) != 0 {
+		t.Errorf("Logged %d events for a change outside of a command", len(events))
+	}
+}
+
+func TestRebuildStorageEngine(t *testing.T) {
+	e := newTestEngine(t)
+	c, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 4, true)
+	c.CreateArena("Court 1")
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := tourney.GetTeams()
+	first := round.CreateGame(teams[:2], true)
+	first.SetScores([]int64{3, 1})
+	first.SetFinal()
+	second := round.CreateGame(teams[2:], true)
+	second.SetScores([]int64{2, 2})
+	tourney.Withdraw(teams[3])
+	if err := c.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	teams[0].RemovePlayer(teams[0].GetPlayers()[0])
+
+	dir, err := ioutil.TempDir("", "competition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rebuilt, err := RebuildStorageEngine(filepath.Join(dir, "rebuilt.db"), e.GetEvents())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := describe(rebuilt), describe(e); got != want {
+		t.Errorf("Rebuilt engine holds\n%s\nexpected\n%s", got, want)
+	}
+	if got, want := len(rebuilt.GetEvents()), len(e.GetEvents()); got != want {
+		t.Errorf("Rebuilt engine has %d events, expected %d", got, want)
+	}
+
+	// The commands are rebuilt too, so the rebuilt engine undoes the same changes
+	if err := c.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rebuilt.GetCompetitions()[0].Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := describe(rebuilt), describe(e); got != want {
+		t.Errorf("Rebuilt engine holds\n%s\nafter undo, expected\n%s", got, want)
+	}
+}
+
+// describe lists everything stored in an engine that can be read back through the models interfaces
+func describe(e models.StorageEngine) string {
+	var b strings.Builder
+	for _, c := range e.GetCompetitions() {
+		fmt.Fprintf(&b, "%s\n", c.GetName())
+		for _, a := range c.GetArenas() {
+			fmt.Fprintf(&b, " arena %s\n", a.GetName())
+		}
+		for _, t := range c.GetAllTournaments() {
+			fmt.Fprintf(&b, " %s %v\n", t.GetName(), t.GetStatus())
+			for _, team := range t.GetTeams() {
+				fmt.Fprintf(&b, "  team %s withdrawn=%v players=%d\n", team.GetName(), team.IsWithdrawn(), len(team.GetPlayers()))
+			}
+			for i, r := range t.GetAllRounds() {
+				fmt.Fprintf(&b, "  round %d %v\n", i+1, r.GetStatus())
+				for _, g := range r.GetGames() {
+					fmt.Fprintf(&b, "   game %v scores=%v places=%v results=%v\n", g.GetStatus(), g.GetScores(), g.GetPlaces(), g.GetResults())
+				}
+			}
+		}
+	}
+	return b.String()
+}
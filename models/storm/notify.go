@@ -0,0 +1,169 @@
+package storm
+
+import (
+	"sync"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+
+	"github.com/asdine/storm/q"
+)
+
+// subscribers are the functions to notify of changes to games
+type subscribers struct {
+	sync.Mutex
+	next int
+	fns  map[int]func(models.Change)
+}
+
+// gameFields maps the stored fields of games to the fields reported in a Change
+var gameFields = map[string]string{
+	"Status":    "Status",
+	"ArenaId":   "Arena",
+	"Score":     "Scores",
+	"Place":     "Places",
+	"Bracket":   "Bracket",
+	"StartTime": "Schedule",
+	"EndTime":   "Schedule",
+}
+
+// allGameFields are reported when a whole game was saved, created or removed
+var allGameFields = []string{"Scores", "Places", "Status", "Arena"}
+
+func (e *engine) Subscribe(fn func(models.Change)) func() {
+	subs := e.subscribers
+	subs.Lock()
+	defer subs.Unlock()
+	id := subs.next
+	subs.next++
+	subs.fns[id] = fn
+	return func() {
+		subs.Lock()
+		defer subs.Unlock()
+		delete(subs.fns, id)
+	}
+}
+
+// noteChange records that a stored record of a game changed. Changes are sent to subscribers once the command making them is over
+func (s *store) noteChange(action pb.EventAction, data interface{}, field string) {
+	var g pb.Game
+	fields := allGameFields
+	switch record := data.(type) {
+	case *pb.Game:
+		g = *record // Removed games can't be read back, so the copy from the event is used
+	case *pb.GameTeam:
+		if action == pb.EventAction_CREATED { // Teams are added as part of creating the game
+			return
+		}
+		if err := s.Node.One("Id", record.GameId, &g); err != nil {
+			return
+		}
+		fields = []string{"Scores", "Places"}
+	case *pb.GameOfficial:
+		if err := s.Node.One("Id", record.GameId, &g); err != nil {
+			return
+		}
+		fields = []string{"Officials"}
+		field = ""
+	default:
+		return
+	}
+	if g.ParentId != 0 { // Sub-games of a series are reported through their series
+		return
+	}
+	if field != "" {
+		changed, ok := gameFields[field]
+		if !ok {
+			return
+		}
+		fields = []string{changed}
+	}
+
+	c := s.current
+	for i, pending := range c.pending {
+		if pending.id == g.Id {
+			c.pending[i].Fields = mergeFields(pending.Fields, fields)
+			return
+		}
+	}
+	change, ok := s.locateGame(g)
+	if !ok {
+		return
+	}
+	change.Fields = fields
+	c.pending = append(c.pending, change)
+}
+
+// pendingChange is a Change that hasn't been sent yet
+type pendingChange struct {
+	models.Change
+	id uint64
+}
+
+// locateGame works out which competition, tournament, round and game number a stored game is
+func (s *store) locateGame(g pb.Game) (pendingChange, bool) {
+	change := pendingChange{id: g.Id}
+	var r pb.Round
+	if err := s.Node.One("Id", g.RoundId, &r); err != nil {
+		return change, false
+	}
+	var t pb.Tournament
+	if err := s.Node.One("Id", r.TournamentId, &t); err != nil {
+		return change, false
+	}
+	var c pb.Competition
+	if err := s.Node.One("Id", t.CompetitionId, &c); err != nil {
+		return change, false
+	}
+	rounds, _ := s.Node.Select(q.Eq("TournamentId", t.Id), q.Lt("Id", r.Id)).Count(new(pb.Round))
+	games, _ := s.Node.Select(q.Eq("RoundId", r.Id), q.Eq("ParentId", uint64(0)), q.Lt("Id", g.Id)).Count(new(pb.Game))
+
+	change.Competition = c.Name
+	change.Tournament = t.Name
+	change.Round = rounds + 1
+	change.GameNumber = games + 1
+	return change, true
+}
+
+// notify sends changes to the subscribers
+func (s *store) notify(pending []pendingChange) {
+	if len(pending) == 0 {
+		return
+	}
+
+	s.subscribers.Lock()
+	fns := make([]func(models.Change), 0, len(s.subscribers.fns))
+	for _, fn := range s.subscribers.fns {
+		fns = append(fns, fn)
+	}
+	s.subscribers.Unlock()
+
+	for _, p := range pending {
+		change := p.Change
+		var g pb.Game
+		if err := s.Node.One("Id", p.id, &g); err == nil {
+			change.Game = &game{g, s}
+		}
+		for _, fn := range fns {
+			fn(change)
+		}
+	}
+}
+
+// mergeFields adds the fields that aren't already in fields
+func mergeFields(fields []string, more []string) []string {
+	merged := append([]string{}, fields...)
+	for _, f := range more {
+		found := false
+		for _, existing := range merged {
+			if existing == f {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, f)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,234 @@
+package storm
+
+import (
+	"fmt"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+
+	"github.com/asdine/storm/q"
+)
+
+type official struct {
+	pb.Official
+	*store
+}
+
+func (c *competition) CreateOfficial(name string, p models.Player) models.Official {
+	o := official{store: c.store}
+	c.command("CreateOfficial", func(c *competition) error {
+		o.store = c.store
+		o.Name = name
+		o.CompetitionId = c.Id
+		if p != nil {
+			var pbPlayer pb.Player
+			c.One("Name", p.GetName(), &pbPlayer)
+			o.PlayerId = pbPlayer.Id
+		}
+		err := c.Save(&o.Official)
+		if err != nil {
+			fmt.Println("Unable to create official:", err)
+		}
+		return err
+	})
+	return &o
+}
+
+func (c *competition) TeamOfficial(t models.Team) models.Official {
+	tm, ok := t.(*team)
+	if !ok {
+		fmt.Println("Unable to find team to officiate:", t.GetName())
+		return nil
+	}
+	o := official{store: c.store}
+	if c.Select(q.Eq("CompetitionId", c.Id), q.Eq("TeamId", tm.Id)).First(&o.Official) == nil {
+		return &o
+	}
+
+	c.command("TeamOfficial", func(c *competition) error {
+		o.store = c.store
+		o.Name = tm.Name
+		o.CompetitionId = c.Id
+		o.TeamId = tm.Id
+		err := c.Save(&o.Official)
+		if err != nil {
+			fmt.Println("Unable to create official:", err)
+		}
+		return err
+	})
+	return &o
+}
+
+func (c *competition) GetOfficials() []models.Official {
+	var officials []models.Official
+	err := c.Select(q.Eq("CompetitionId", c.Id)).Each(new(pb.Official), func(record interface{}) error {
+		o := record.(*pb.Official)
+		officials = append(officials, &official{*o, c.store})
+		return nil
+	})
+	if err != nil {
+		fmt.Println("Error getting officials:", err)
+	}
+	return officials
+}
+
+func (c *competition) SetLosersRef(role string) {
+	c.command("SetLosersRef", func(c *competition) error {
+		c.LosersRef = role
+		err := c.UpdateField(&c.Competition, "LosersRef", role)
+		if err != nil {
+			fmt.Println("Unable to set losers ref:", err)
+		}
+		return err
+	})
+}
+
+func (c *competition) GetLosersRef() string {
+	return c.LosersRef
+}
+
+func (o *official) GetName() string {
+	return o.Name
+}
+
+func (o *official) GetPlayer() models.Player {
+	if o.PlayerId == 0 {
+		return nil
+	}
+	p := player{store: o.store}
+	if o.One("Id", o.PlayerId, &p.Player) != nil {
+		return nil
+	}
+	return &p
+}
+
+func (o *official) GetTeam() models.Team {
+	if o.TeamId == 0 {
+		return nil
+	}
+	t := team{store: o.store}
+	if o.One("Id", o.TeamId, &t.Team) != nil {
+		return nil
+	}
+	return &t
+}
+
+func (o *official) GetGames() []models.Game {
+	var gameIds []uint64
+	o.Select(q.Eq("OfficialId", o.Id)).Each(new(pb.GameOfficial), func(record interface{}) error {
+		gameIds = append(gameIds, record.(*pb.GameOfficial).GameId)
+		return nil
+	})
+	var games []models.Game
+	o.Select(q.In("Id", gameIds)).Each(new(pb.Game), func(record interface{}) error {
+		g := record.(*pb.Game)
+		games = append(games, &game{*g, o.store})
+		return nil
+	})
+	return games
+}
+
+func (r *round) AssignOfficials(assignments []models.Assignment) {
+	r.command("AssignOfficials", func(r *round) error {
+		for _, a := range assignments {
+			g, ok := a.Game.(*game)
+			if !ok {
+				continue
+			}
+			g.join(r.store, func(g *game) error {
+				g.AssignOfficial(a.Official, a.Role)
+				return nil
+			})
+		}
+		return nil
+	})
+}
+
+func (g *game) AssignOfficial(o models.Official, role string) {
+	g.command("AssignOfficial", func(g *game) error {
+		off, ok := o.(*official)
+		if !ok {
+			fmt.Println("Unable to find official:", o.GetName())
+			return nil
+		}
+		if models.PlaysIn(o, g) {
+			fmt.Println("Unable to assign official:", o.GetName(), "plays in this game")
+			return nil
+		}
+
+		var existing []pb.GameOfficial
+		g.Select(q.Eq("GameId", g.Id), q.Eq("Role", role)).Find(&existing)
+		for i := range existing {
+			g.DeleteStruct(&existing[i])
+		}
+		err := g.Save(&pb.GameOfficial{GameId: g.Id, OfficialId: off.Id, Role: role})
+		if err != nil {
+			fmt.Println("Unable to assign official:", err)
+		}
+		return err
+	})
+}
+
+func (g *game) GetOfficials() []models.Assignment {
+	var assignments []models.Assignment
+	g.Select(q.Eq("GameId", g.Id)).OrderBy("Id").Each(new(pb.GameOfficial), func(record interface{}) error {
+		gameOfficial := record.(*pb.GameOfficial)
+		o := official{store: g.store}
+		if g.One("Id", gameOfficial.OfficialId, &o.Official) == nil {
+			assignments = append(assignments, models.Assignment{Game: g, Official: &o, Role: gameOfficial.Role})
+		}
+		return nil
+	})
+	return assignments
+}
+
+// losersRef gives the losing team of a game that has just finished the competition's losers ref role, in the next game waiting at the arena it was played in
+func (g *game) losersRef() {
+	var c competition
+	if g.One("Id", g.getTournament().CompetitionId, &c.Competition) != nil || c.LosersRef == "" {
+		return
+	}
+	c.store = g.store
+
+	// The loser is the team that lost and placed last, there isn't one if the game was drawn
+	var loser *team
+	worst := int64(-1)
+	var gts []pb.GameTeam
+	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+	for _, gt := range gts {
+		place := gt.Place
+		if place < 0 {
+			place = -place - 1
+		}
+		if gt.Result != pb.Result_LOSS || place <= worst {
+			continue
+		}
+		t := team{store: g.store}
+		if g.One("Id", gt.TeamId, &t.Team) == nil && !models.IsByeTeam(&t) {
+			loser, worst = &t, place
+		}
+	}
+	if loser == nil {
+		return
+	}
+
+	a := arena{store: g.store}
+	if g.One("Id", g.ArenaId, &a.Arena) != nil {
+		return
+	}
+	ref := c.TeamOfficial(loser)
+	for _, waiting := range a.queue() {
+		next := &game{waiting, g.store}
+		if waiting.Status != pb.Status_NEW || models.PlaysIn(ref, next) || hasRole(next, c.LosersRef) {
+			continue
+		}
+		next.AssignOfficial(ref, c.LosersRef)
+		return
+	}
+}
+
+// hasRole reports whether a game already has an official in a role
+func hasRole(g *game, role string) bool {
+	n, _ := g.Select(q.Eq("GameId", g.Id), q.Eq("Role", role)).Count(new(pb.GameOfficial))
+	return n > 0
+}
@@ -0,0 +1,56 @@
+package storm
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+func TestAssignOfficial(t *testing.T) {
+	e := newTestEngine(t)
+	c, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 4, true)
+	ref := c.CreateOfficial("Ref", nil)
+	player := c.CreateOfficial("Player", e.GetPlayer("A 1"))
+	if officials := c.GetOfficials(); len(officials) != 2 || officials[1].GetPlayer().GetName() != "A 1" {
+		t.Fatalf("Competition has officials %v, expected Ref and Player, who plays as A 1", officials)
+	}
+
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := tourney.GetTeams()
+	first := round.CreateGame(teams[:2], true)
+	second := round.CreateGame(teams[2:], true)
+	first.AssignOfficial(ref, "Referee")
+	second.AssignOfficial(player, "Referee")
+	second.AssignOfficial(ref, "Scorekeeper")
+
+	if games := ref.GetGames(); len(games) != 2 {
+		t.Errorf("Ref has %d games, expected 2", len(games))
+	}
+	assigned := second.GetOfficials()
+	if len(assigned) != 2 || assigned[0].Official.GetName() != "Player" || assigned[0].Role != "Referee" || assigned[1].Official.GetName() != "Ref" || assigned[1].Role != "Scorekeeper" {
+		t.Errorf("Second game has officials %v, expected Player refereeing and Ref keeping score", assigned)
+	}
+
+	// A role has a single official, assigning it again replaces them
+	second.AssignOfficial(ref, "Referee")
+	if assigned := second.GetOfficials(); len(assigned) != 2 || len(player.GetGames()) != 0 {
+		t.Errorf("Second game has officials %v after replacing its referee, expected 2", assigned)
+	}
+
+	// Officials aren't given games their own team plays in
+	first.AssignOfficial(player, "Scorekeeper")
+	if assigned := first.GetOfficials(); len(assigned) != 1 || assigned[0].Official.GetName() != "Ref" {
+		t.Errorf("First game has officials %v, expected only Ref as A 1 plays in it", assigned)
+	}
+	teamRef := c.TeamOfficial(teams[0])
+	first.AssignOfficial(teamRef, "Scorekeeper")
+	if games := teamRef.GetGames(); len(games) != 0 {
+		t.Errorf("Team was given %d of its own games to officiate", len(games))
+	}
+	if again := c.TeamOfficial(teams[0]); again.GetName() != teamRef.GetName() || len(c.GetOfficials()) != 3 {
+		t.Errorf("Team has more than one official")
+	}
+}
@@ -88,9 +88,103 @@ func (TournamentType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0b5431a010549573, []int{1}
 }
 
+type PeriodType int32
+
+const (
+	PeriodType_POINTS PeriodType = 0
+	PeriodType_SETS   PeriodType = 1
+)
+
+var PeriodType_name = map[int32]string{
+	0: "POINTS",
+	1: "SETS",
+}
+
+var PeriodType_value = map[string]int32{
+	"POINTS": 0,
+	"SETS":   1,
+}
+
+func (x PeriodType) String() string {
+	return proto.EnumName(PeriodType_name, int32(x))
+}
+
+func (PeriodType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{2}
+}
+
+type Result int32
+
+const (
+	Result_UNDECIDED    Result = 0
+	Result_WIN          Result = 1
+	Result_LOSS         Result = 2
+	Result_DRAW         Result = 3
+	Result_FORFEIT      Result = 4
+	Result_DISQUALIFIED Result = 5
+	Result_WALKOVER     Result = 6
+)
+
+var Result_name = map[int32]string{
+	0: "UNDECIDED",
+	1: "WIN",
+	2: "LOSS",
+	3: "DRAW",
+	4: "FORFEIT",
+	5: "DISQUALIFIED",
+	6: "WALKOVER",
+}
+
+var Result_value = map[string]int32{
+	"UNDECIDED":    0,
+	"WIN":          1,
+	"LOSS":         2,
+	"DRAW":         3,
+	"FORFEIT":      4,
+	"DISQUALIFIED": 5,
+	"WALKOVER":     6,
+}
+
+func (x Result) String() string {
+	return proto.EnumName(Result_name, int32(x))
+}
+
+func (Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{3}
+}
+
+type EventAction int32
+
+const (
+	EventAction_CREATED EventAction = 0
+	EventAction_UPDATED EventAction = 1
+	EventAction_DELETED EventAction = 2
+)
+
+var EventAction_name = map[int32]string{
+	0: "CREATED",
+	1: "UPDATED",
+	2: "DELETED",
+}
+
+var EventAction_value = map[string]int32{
+	"CREATED": 0,
+	"UPDATED": 1,
+	"DELETED": 2,
+}
+
+func (x EventAction) String() string {
+	return proto.EnumName(EventAction_name, int32(x))
+}
+
+func (EventAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{4}
+}
+
 type Competition struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LosersRef            string   `protobuf:"bytes,3,opt,name=losers_ref,json=losersRef,proto3" json:"losers_ref,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -143,6 +237,13 @@ func (m *Competition) GetName() string {
 	return ""
 }
 
+func (m *Competition) GetLosersRef() string {
+	if m != nil {
+		return m.LosersRef
+	}
+	return ""
+}
+
 type CompetitionTeam struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	CompetitionId        uint64   `protobuf:"varint,2,opt,name=competitionId,proto3" json:"competitionId,omitempty"`
@@ -234,6 +335,8 @@ type Tournament struct {
 	Scored               bool           `protobuf:"varint,9,opt,name=scored,proto3" json:"scored,omitempty"`
 	BracketOrder         []string       `protobuf:"bytes,10,rep,name=bracket_order,json=bracketOrder,proto3" json:"bracket_order,omitempty"`
 	Metadata             []byte         `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ForfeitScore         int64          `protobuf:"varint,12,opt,name=forfeit_score,json=forfeitScore,proto3" json:"forfeit_score,omitempty"`
+	RoundCount           uint32         `protobuf:"varint,13,opt,name=round_count,json=roundCount,proto3" json:"round_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -349,6 +452,20 @@ func (m *Tournament) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Tournament) GetForfeitScore() int64 {
+	if m != nil {
+		return m.ForfeitScore
+	}
+	return 0
+}
+
+func (m *Tournament) GetRoundCount() uint32 {
+	if m != nil {
+		return m.RoundCount
+	}
+	return 0
+}
+
 type TournamentTeam struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	TournamentId         uint64   `protobuf:"varint,2,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
@@ -417,6 +534,7 @@ type Team struct {
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	TournamentId         uint64   `protobuf:"varint,3,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
 	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Withdrawn            bool     `protobuf:"varint,5,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -483,6 +601,13 @@ func (m *Team) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Team) GetWithdrawn() bool {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return false
+}
+
 type Player struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" storm:"unique"`
@@ -558,6 +683,7 @@ type PlayerTeam struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	PlayerId             uint64   `protobuf:"varint,2,opt,name=playerId,proto3" json:"playerId,omitempty"`
 	TeamId               uint64   `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	Removed              bool     `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -617,10 +743,105 @@ func (m *PlayerTeam) GetTeamId() uint64 {
 	return 0
 }
 
+func (m *PlayerTeam) GetRemoved() bool {
+	if m != nil {
+		return m.Removed
+	}
+	return false
+}
+
+type RosterChange struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	TeamId               uint64   `protobuf:"varint,2,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	PlayerId             uint64   `protobuf:"varint,3,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Added                bool     `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"`
+	Timestamp            int64    `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Command              uint64   `protobuf:"varint,6,opt,name=command,proto3" json:"command,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RosterChange) Reset()         { *m = RosterChange{} }
+func (m *RosterChange) String() string { return proto.CompactTextString(m) }
+func (*RosterChange) ProtoMessage()    {}
+func (*RosterChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{7}
+}
+func (m *RosterChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RosterChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RosterChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RosterChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RosterChange.Merge(m, src)
+}
+func (m *RosterChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *RosterChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_RosterChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RosterChange proto.InternalMessageInfo
+
+func (m *RosterChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RosterChange) GetTeamId() uint64 {
+	if m != nil {
+		return m.TeamId
+	}
+	return 0
+}
+
+func (m *RosterChange) GetPlayerId() uint64 {
+	if m != nil {
+		return m.PlayerId
+	}
+	return 0
+}
+
+func (m *RosterChange) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+func (m *RosterChange) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *RosterChange) GetCommand() uint64 {
+	if m != nil {
+		return m.Command
+	}
+	return 0
+}
+
 type Round struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	Status               Status   `protobuf:"varint,2,opt,name=status,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Status" json:"status,omitempty"`
 	TournamentId         uint64   `protobuf:"varint,3,opt,name=tournamentId,proto3" json:"tournamentId,omitempty"`
+	SeriesLength         uint32   `protobuf:"varint,4,opt,name=series_length,json=seriesLength,proto3" json:"series_length,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -630,7 +851,7 @@ func (m *Round) Reset()         { *m = Round{} }
 func (m *Round) String() string { return proto.CompactTextString(m) }
 func (*Round) ProtoMessage()    {}
 func (*Round) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5431a010549573, []int{7}
+	return fileDescriptor_0b5431a010549573, []int{8}
 }
 func (m *Round) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,22 +901,36 @@ func (m *Round) GetTournamentId() uint64 {
 	return 0
 }
 
+func (m *Round) GetSeriesLength() uint32 {
+	if m != nil {
+		return m.SeriesLength
+	}
+	return 0
+}
+
 type Game struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
-	ArenaId              uint32   `protobuf:"varint,2,opt,name=arenaId,proto3" json:"arenaId,omitempty"`
-	RoundId              uint64   `protobuf:"varint,3,opt,name=roundId,proto3" json:"roundId,omitempty"`
-	Status               Status   `protobuf:"varint,4,opt,name=status,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Status" json:"status,omitempty"`
-	Bracket              string   `protobuf:"bytes,5,opt,name=bracket,proto3" json:"bracket,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	ArenaId              uint32     `protobuf:"varint,2,opt,name=arenaId,proto3" json:"arenaId,omitempty"`
+	RoundId              uint64     `protobuf:"varint,3,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Status               Status     `protobuf:"varint,4,opt,name=status,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Status" json:"status,omitempty"`
+	Bracket              string     `protobuf:"bytes,5,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	SeriesLength         uint32     `protobuf:"varint,6,opt,name=series_length,json=seriesLength,proto3" json:"series_length,omitempty"`
+	ParentId             uint64     `protobuf:"varint,7,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	PeriodType           PeriodType `protobuf:"varint,8,opt,name=period_type,json=periodType,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.PeriodType" json:"period_type,omitempty"`
+	RegulationPeriods    uint32     `protobuf:"varint,9,opt,name=regulation_periods,json=regulationPeriods,proto3" json:"regulation_periods,omitempty"`
+	StartTime            int64      `protobuf:"varint,10,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              int64      `protobuf:"varint,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	FinishedCommand      uint64     `protobuf:"varint,12,opt,name=finished_command,json=finishedCommand,proto3" json:"finished_command,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Game) Reset()         { *m = Game{} }
 func (m *Game) String() string { return proto.CompactTextString(m) }
 func (*Game) ProtoMessage()    {}
 func (*Game) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5431a010549573, []int{8}
+	return fileDescriptor_0b5431a010549573, []int{9}
 }
 func (m *Game) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,12 +994,64 @@ func (m *Game) GetBracket() string {
 	return ""
 }
 
+func (m *Game) GetSeriesLength() uint32 {
+	if m != nil {
+		return m.SeriesLength
+	}
+	return 0
+}
+
+func (m *Game) GetParentId() uint64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+func (m *Game) GetPeriodType() PeriodType {
+	if m != nil {
+		return m.PeriodType
+	}
+	return PeriodType_POINTS
+}
+
+func (m *Game) GetRegulationPeriods() uint32 {
+	if m != nil {
+		return m.RegulationPeriods
+	}
+	return 0
+}
+
+func (m *Game) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Game) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Game) GetFinishedCommand() uint64 {
+	if m != nil {
+		return m.FinishedCommand
+	}
+	return 0
+}
+
 type GameTeam struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	GameId               uint64   `protobuf:"varint,2,opt,name=gameId,proto3" json:"gameId,omitempty"`
 	TeamId               uint64   `protobuf:"varint,3,opt,name=teamId,proto3" json:"teamId,omitempty"`
 	Score                int64    `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
 	Place                int64    `protobuf:"varint,5,opt,name=place,proto3" json:"place,omitempty"`
+	PeriodScores         []int64  `protobuf:"varint,6,rep,packed,name=period_scores,json=periodScores,proto3" json:"period_scores,omitempty"`
+	Result               Result   `protobuf:"varint,7,opt,name=result,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Result" json:"result,omitempty"`
+	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -774,7 +1061,7 @@ func (m *GameTeam) Reset()         { *m = GameTeam{} }
 func (m *GameTeam) String() string { return proto.CompactTextString(m) }
 func (*GameTeam) ProtoMessage()    {}
 func (*GameTeam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5431a010549573, []int{9}
+	return fileDescriptor_0b5431a010549573, []int{10}
 }
 func (m *GameTeam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,9 +1125,31 @@ func (m *GameTeam) GetPlace() int64 {
 	return 0
 }
 
+func (m *GameTeam) GetPeriodScores() []int64 {
+	if m != nil {
+		return m.PeriodScores
+	}
+	return nil
+}
+
+func (m *GameTeam) GetResult() Result {
+	if m != nil {
+		return m.Result
+	}
+	return Result_UNDECIDED
+}
+
+func (m *GameTeam) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type Arena struct {
 	Id                   uint32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CompetitionId        uint64   `protobuf:"varint,3,opt,name=competitionId,proto3" json:"competitionId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -850,7 +1159,7 @@ func (m *Arena) Reset()         { *m = Arena{} }
 func (m *Arena) String() string { return proto.CompactTextString(m) }
 func (*Arena) ProtoMessage()    {}
 func (*Arena) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b5431a010549573, []int{10}
+	return fileDescriptor_0b5431a010549573, []int{11}
 }
 func (m *Arena) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,362 +1202,581 @@ func (m *Arena) GetName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.Status", Status_name, Status_value)
-	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.TournamentType", TournamentType_name, TournamentType_value)
-	proto.RegisterType((*Competition)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Competition")
-	proto.RegisterType((*CompetitionTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionTeam")
-	proto.RegisterType((*Tournament)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Tournament")
-	proto.RegisterType((*TournamentTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.TournamentTeam")
-	proto.RegisterType((*Team)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Team")
-	proto.RegisterType((*Player)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Player")
-	proto.RegisterType((*PlayerTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.PlayerTeam")
-	proto.RegisterType((*Round)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Round")
-	proto.RegisterType((*Game)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Game")
-	proto.RegisterType((*GameTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.GameTeam")
-	proto.RegisterType((*Arena)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Arena")
+func (m *Arena) GetCompetitionId() uint64 {
+	if m != nil {
+		return m.CompetitionId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("models.proto", fileDescriptor_0b5431a010549573) }
+type Correction struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	GameId               uint64   `protobuf:"varint,2,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	Official             string   `protobuf:"bytes,3,opt,name=official,proto3" json:"official,omitempty"`
+	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OldScores            []int64  `protobuf:"varint,5,rep,packed,name=old_scores,json=oldScores,proto3" json:"old_scores,omitempty"`
+	NewScores            []int64  `protobuf:"varint,6,rep,packed,name=new_scores,json=newScores,proto3" json:"new_scores,omitempty"`
+	OldPlaces            []int64  `protobuf:"varint,7,rep,packed,name=old_places,json=oldPlaces,proto3" json:"old_places,omitempty"`
+	NewPlaces            []int64  `protobuf:"varint,8,rep,packed,name=new_places,json=newPlaces,proto3" json:"new_places,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_0b5431a010549573 = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xef, 0x24, 0xce, 0xbf, 0xd7, 0x24, 0x6b, 0x8d, 0xaa, 0xca, 0x2a, 0xa8, 0x1b, 0x19, 0x84,
-	0xac, 0x15, 0xeb, 0x95, 0x16, 0x71, 0x41, 0xe2, 0x90, 0x6c, 0x42, 0x64, 0x91, 0xda, 0xd1, 0x38,
-	0x51, 0xb5, 0x5c, 0x22, 0x27, 0x33, 0x04, 0x97, 0xfa, 0x0f, 0xf6, 0xb8, 0x52, 0xf7, 0x0c, 0x37,
-	0x0e, 0x1c, 0xf9, 0x02, 0xdc, 0xf8, 0x20, 0x9c, 0x10, 0x17, 0xae, 0x2b, 0x54, 0xbe, 0xc1, 0x7e,
-	0x02, 0x34, 0x13, 0xd7, 0x49, 0xcb, 0x16, 0xad, 0x97, 0xee, 0x2d, 0xbf, 0xdf, 0x78, 0x7e, 0xef,
-	0xf7, 0xde, 0x9b, 0xf7, 0x02, 0xed, 0x20, 0xa2, 0xec, 0x3c, 0x35, 0xe3, 0x24, 0xe2, 0x11, 0xfe,
-	0x94, 0xb2, 0x0b, 0xf3, 0x2c, 0x4b, 0xb9, 0x1f, 0x9e, 0x65, 0x94, 0x9a, 0x51, 0xb2, 0xce, 0xa1,
-	0xb9, 0x8a, 0x82, 0x98, 0x71, 0x9f, 0xfb, 0x51, 0x68, 0xe6, 0x77, 0x52, 0x1e, 0x25, 0x81, 0x19,
-	0x2f, 0x8f, 0x1e, 0xaf, 0x7d, 0xfe, 0x4d, 0xb6, 0x14, 0xdf, 0x3c, 0x59, 0x47, 0xeb, 0xe8, 0x89,
-	0x54, 0x5b, 0x66, 0x5f, 0x4b, 0x24, 0x81, 0xfc, 0xb5, 0x89, 0xa2, 0x7f, 0x09, 0xfb, 0xcf, 0xb6,
-	0x6a, 0xd8, 0x80, 0x8a, 0x4f, 0x35, 0xd4, 0x43, 0x86, 0x32, 0xd0, 0x5e, 0xbd, 0x7c, 0x78, 0x20,
-	0x75, 0x3f, 0xd3, 0x7d, 0xfa, 0xb1, 0x1f, 0xae, 0x12, 0x16, 0xb0, 0x90, 0xeb, 0xa4, 0xe2, 0x53,
-	0x8c, 0x41, 0x09, 0xbd, 0x80, 0x69, 0x95, 0x1e, 0x32, 0x5a, 0x44, 0xfe, 0xd6, 0x7f, 0x41, 0xf0,
-	0x60, 0x47, 0x6d, 0xc6, 0xbc, 0xa0, 0x84, 0xe2, 0x87, 0xd0, 0xd9, 0x49, 0xcc, 0xa2, 0x52, 0x5a,
-	0x21, 0x37, 0x49, 0x7c, 0x08, 0x75, 0xce, 0xbc, 0xc0, 0xa2, 0x5a, 0x55, 0x1e, 0xe7, 0x08, 0x1f,
-	0x40, 0x2d, 0x5d, 0x45, 0x09, 0xd3, 0x94, 0x1e, 0x32, 0xaa, 0x64, 0x03, 0x04, 0x1b, 0x9f, 0x7b,
-	0x2b, 0xa6, 0xd5, 0x36, 0xac, 0x04, 0xfa, 0xef, 0x55, 0x80, 0x59, 0x94, 0x25, 0xc2, 0x74, 0xc8,
-	0xff, 0x5f, 0xd2, 0xf8, 0x39, 0x28, 0xfc, 0x32, 0x66, 0xd2, 0x4e, 0xf7, 0xe9, 0xc8, 0x7c, 0xab,
-	0xb6, 0x99, 0x5b, 0x3b, 0xb3, 0xcb, 0x98, 0x11, 0x29, 0x89, 0xe7, 0x50, 0x4f, 0xb9, 0xc7, 0xb3,
-	0x54, 0x26, 0xd5, 0x7d, 0xfa, 0xf9, 0x5b, 0x8a, 0xbb, 0x52, 0x84, 0xe4, 0x62, 0xff, 0x2e, 0x74,
-	0xed, 0x8e, 0x42, 0xa7, 0x8c, 0x51, 0x46, 0xb5, 0x7a, 0x0f, 0x19, 0x4d, 0x92, 0x23, 0xfc, 0x1e,
-	0xb4, 0xd6, 0x5e, 0xc0, 0x16, 0xa9, 0xff, 0x82, 0x69, 0x8d, 0x1e, 0x32, 0x3a, 0xa4, 0x29, 0x08,
-	0xd7, 0x7f, 0xc1, 0xf0, 0xfb, 0xd0, 0xf2, 0xe8, 0x85, 0x17, 0xae, 0xfc, 0x70, 0xad, 0x35, 0xe5,
-	0xe1, 0x96, 0x90, 0x92, 0xa2, 0x2d, 0x54, 0x6b, 0xe5, 0x92, 0x12, 0xe1, 0x0f, 0xa0, 0xb3, 0x4c,
-	0xbc, 0xd5, 0xb7, 0x8c, 0x2f, 0xa2, 0x84, 0xb2, 0x44, 0x83, 0x5e, 0xd5, 0x68, 0x91, 0x76, 0x4e,
-	0x3a, 0x82, 0xc3, 0x47, 0xd0, 0x0c, 0x18, 0xf7, 0xa8, 0xc7, 0x3d, 0x6d, 0xbf, 0x87, 0x8c, 0x36,
-	0x29, 0xb0, 0x7e, 0x01, 0xdd, 0x9d, 0x02, 0x96, 0x7b, 0x76, 0x3a, 0xb4, 0x79, 0x71, 0xb7, 0x78,
-	0x75, 0x37, 0xb8, 0xbb, 0x1e, 0x9d, 0xfe, 0x3d, 0x02, 0xa5, 0x64, 0xb8, 0xd7, 0x3d, 0xa1, 0xdb,
-	0x16, 0xaa, 0xaf, 0xb1, 0xb0, 0x9b, 0xbe, 0x72, 0x2b, 0xfd, 0x1f, 0x11, 0xd4, 0xa7, 0xe7, 0xde,
-	0x25, 0x4b, 0x4a, 0x18, 0xf9, 0x68, 0xd7, 0xc8, 0x00, 0xbf, 0x7a, 0xf9, 0xb0, 0x9b, 0x7f, 0x9b,
-	0x85, 0xfe, 0x77, 0x19, 0xd3, 0x73, 0x73, 0xc5, 0x60, 0x6d, 0x5c, 0xe5, 0x83, 0xf5, 0x5f, 0x76,
-	0xce, 0x00, 0x36, 0x6e, 0x4a, 0x96, 0xe6, 0x08, 0x9a, 0xb1, 0xbc, 0x57, 0x74, 0xa1, 0xc0, 0x77,
-	0x76, 0xe0, 0x57, 0x04, 0x35, 0x12, 0x65, 0x21, 0x2d, 0x11, 0x67, 0x3b, 0x56, 0x95, 0xfb, 0x1c,
-	0xab, 0x37, 0xe8, 0xa2, 0xfe, 0x27, 0x02, 0x65, 0x2c, 0xaa, 0xfa, 0xe6, 0x6e, 0x35, 0x68, 0x78,
-	0x09, 0x0b, 0xbd, 0xbc, 0x28, 0x1d, 0x72, 0x0d, 0xc5, 0x49, 0x22, 0x52, 0x2f, 0x62, 0x5d, 0xc3,
-	0x77, 0xb5, 0x38, 0x34, 0x68, 0xe4, 0x23, 0x29, 0x57, 0x46, 0x8b, 0x5c, 0x43, 0xfd, 0x27, 0x04,
-	0x4d, 0x91, 0x57, 0xc9, 0x8e, 0x1f, 0x42, 0x5d, 0xac, 0x8e, 0xa2, 0xdf, 0x39, 0xba, 0x97, 0x25,
-	0x3f, 0x82, 0x5a, 0x5f, 0x14, 0x6a, 0xc7, 0x4e, 0xa7, 0xfc, 0x6c, 0x3e, 0x7a, 0x0c, 0xf5, 0x4d,
-	0x15, 0x70, 0x03, 0xaa, 0xf6, 0xe8, 0x54, 0xdd, 0xc3, 0xfb, 0xd0, 0x70, 0xec, 0xb1, 0x63, 0xd9,
-	0x63, 0x15, 0xe1, 0x0e, 0xb4, 0x9e, 0x39, 0x27, 0xd3, 0xc9, 0x68, 0x36, 0x1a, 0xaa, 0x95, 0x47,
-	0x3f, 0xa0, 0x1b, 0xab, 0x48, 0x6c, 0xf1, 0x43, 0xc0, 0xae, 0x65, 0x8f, 0x27, 0xa3, 0xc5, 0x68,
-	0x62, 0x9d, 0x58, 0x76, 0x7f, 0x66, 0x39, 0xb6, 0xba, 0x27, 0xf8, 0xa1, 0x33, 0x1f, 0xdc, 0xe2,
-	0x11, 0x7e, 0x00, 0xfb, 0xc4, 0x99, 0xdb, 0xc3, 0x05, 0x71, 0x06, 0x96, 0xad, 0x56, 0xb0, 0x0a,
-	0x6d, 0x11, 0xa2, 0xef, 0xba, 0x8b, 0x21, 0xe9, 0x9f, 0xaa, 0x55, 0xc1, 0xb8, 0xa7, 0x96, 0xeb,
-	0x2e, 0xbe, 0x70, 0xc8, 0x49, 0x7f, 0xa6, 0x2a, 0xb8, 0x0b, 0x30, 0x26, 0xce, 0x7c, 0xba, 0x98,
-	0x4e, 0xfa, 0xcf, 0xd5, 0xda, 0xe0, 0xe0, 0xb7, 0xab, 0x63, 0xf4, 0xc7, 0xd5, 0x31, 0xfa, 0xeb,
-	0xea, 0x18, 0xfd, 0xfc, 0xf7, 0xf1, 0xde, 0x57, 0x95, 0x78, 0xb9, 0xac, 0xcb, 0x3f, 0xfd, 0x4f,
-	0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x95, 0x5b, 0x68, 0x6a, 0x08, 0x00, 0x00,
+func (m *Correction) Reset()         { *m = Correction{} }
+func (m *Correction) String() string { return proto.CompactTextString(m) }
+func (*Correction) ProtoMessage()    {}
+func (*Correction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{12}
+}
+func (m *Correction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Correction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Correction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Correction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Correction.Merge(m, src)
+}
+func (m *Correction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Correction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Correction.DiscardUnknown(m)
 }
 
-func (m *Competition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Correction proto.InternalMessageInfo
+
+func (m *Correction) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Competition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Correction) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
+	}
+	return 0
 }
 
-func (m *Competition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Correction) GetOfficial() string {
+	if m != nil {
+		return m.Official
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CompetitionTeam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Correction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CompetitionTeam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Correction) GetOldScores() []int64 {
+	if m != nil {
+		return m.OldScores
+	}
+	return nil
 }
 
-func (m *CompetitionTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Place != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Place))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Score != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TeamId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CompetitionId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
-		i--
-		dAtA[i] = 0x10
+func (m *Correction) GetNewScores() []int64 {
+	if m != nil {
+		return m.NewScores
 	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Correction) GetOldPlaces() []int64 {
+	if m != nil {
+		return m.OldPlaces
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Tournament) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Correction) GetNewPlaces() []int64 {
+	if m != nil {
+		return m.NewPlaces
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Tournament) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Official struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CompetitionId        uint64   `protobuf:"varint,3,opt,name=competitionId,proto3" json:"competitionId,omitempty"`
+	PlayerId             uint64   `protobuf:"varint,4,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	TeamId               uint64   `protobuf:"varint,5,opt,name=teamId,proto3" json:"teamId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.BracketOrder) > 0 {
-		for iNdEx := len(m.BracketOrder) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BracketOrder[iNdEx])
-			copy(dAtA[i:], m.BracketOrder[iNdEx])
-			i = encodeVarintModels(dAtA, i, uint64(len(m.BracketOrder[iNdEx])))
-			i--
-			dAtA[i] = 0x52
-		}
-	}
-	if m.Scored {
-		i--
-		if m.Scored {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Advancing != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Advancing))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.GameSize != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.GameSize))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Seeded {
-		i--
-		if m.Seeded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *Official) Reset()         { *m = Official{} }
+func (m *Official) String() string { return proto.CompactTextString(m) }
+func (*Official) ProtoMessage()    {}
+func (*Official) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{13}
+}
+func (m *Official) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Official) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Official.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.CompetitionId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Status != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Type != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Official) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Official.Merge(m, src)
+}
+func (m *Official) XXX_Size() int {
+	return m.Size()
+}
+func (m *Official) XXX_DiscardUnknown() {
+	xxx_messageInfo_Official.DiscardUnknown(m)
 }
 
-func (m *TournamentTeam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Official proto.InternalMessageInfo
+
+func (m *Official) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TournamentTeam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Official) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *TournamentTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TeamId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TournamentId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TournamentId))
-		i--
-		dAtA[i] = 0x10
+func (m *Official) GetCompetitionId() uint64 {
+	if m != nil {
+		return m.CompetitionId
 	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *Official) GetPlayerId() uint64 {
+	if m != nil {
+		return m.PlayerId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Team) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Official) GetTeamId() uint64 {
+	if m != nil {
+		return m.TeamId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Team) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GameOfficial struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	GameId               uint64   `protobuf:"varint,2,opt,name=gameId,proto3" json:"gameId,omitempty"`
+	OfficialId           uint64   `protobuf:"varint,3,opt,name=officialId,proto3" json:"officialId,omitempty"`
+	Role                 string   `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
+func (m *GameOfficial) Reset()         { *m = GameOfficial{} }
+func (m *GameOfficial) String() string { return proto.CompactTextString(m) }
+func (*GameOfficial) ProtoMessage()    {}
+func (*GameOfficial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{14}
+}
+func (m *GameOfficial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameOfficial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameOfficial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TournamentId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TournamentId))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *GameOfficial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameOfficial.Merge(m, src)
+}
+func (m *GameOfficial) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameOfficial) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameOfficial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameOfficial proto.InternalMessageInfo
+
+func (m *GameOfficial) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *GameOfficial) GetGameId() uint64 {
+	if m != nil {
+		return m.GameId
 	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *GameOfficial) GetOfficialId() uint64 {
+	if m != nil {
+		return m.OfficialId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Player) Marshal() (dAtA []byte, err error) {
+func (m *GameOfficial) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type Event struct {
+	Id                   uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	Action               EventAction `protobuf:"varint,2,opt,name=action,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.EventAction" json:"action,omitempty"`
+	Kind                 string      `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	RecordId             uint64      `protobuf:"varint,4,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	Field                string      `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	Data                 []byte      `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp            int64       `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Command              uint64      `protobuf:"varint,8,opt,name=command,proto3" json:"command,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{15}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Event) GetAction() EventAction {
+	if m != nil {
+		return m.Action
+	}
+	return EventAction_CREATED
+}
+
+func (m *Event) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Event) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *Event) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Event) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Event) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Event) GetCommand() uint64 {
+	if m != nil {
+		return m.Command
+	}
+	return 0
+}
+
+type Command struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" storm:"id,increment"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Undone               bool     `protobuf:"varint,4,opt,name=undone,proto3" json:"undone,omitempty"`
+	Target               uint64   `protobuf:"varint,5,opt,name=target,proto3" json:"target,omitempty"`
+	CompetitionId        uint64   `protobuf:"varint,6,opt,name=competitionId,proto3" json:"competitionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Command) Reset()         { *m = Command{} }
+func (m *Command) String() string { return proto.CompactTextString(m) }
+func (*Command) ProtoMessage()    {}
+func (*Command) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b5431a010549573, []int{16}
+}
+func (m *Command) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Command) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Command.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Command) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Command.Merge(m, src)
+}
+func (m *Command) XXX_Size() int {
+	return m.Size()
+}
+func (m *Command) XXX_DiscardUnknown() {
+	xxx_messageInfo_Command.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Command proto.InternalMessageInfo
+
+func (m *Command) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Command) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Command) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Command) GetUndone() bool {
+	if m != nil {
+		return m.Undone
+	}
+	return false
+}
+
+func (m *Command) GetTarget() uint64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *Command) GetCompetitionId() uint64 {
+	if m != nil {
+		return m.CompetitionId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.Status", Status_name, Status_value)
+	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.TournamentType", TournamentType_name, TournamentType_value)
+	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.PeriodType", PeriodType_name, PeriodType_value)
+	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.Result", Result_name, Result_value)
+	proto.RegisterEnum("dev.justinjudd.org.justin.competition.models.storm.pb.EventAction", EventAction_name, EventAction_value)
+	proto.RegisterType((*Competition)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Competition")
+	proto.RegisterType((*CompetitionTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionTeam")
+	proto.RegisterType((*Tournament)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Tournament")
+	proto.RegisterType((*TournamentTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.TournamentTeam")
+	proto.RegisterType((*Team)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Team")
+	proto.RegisterType((*Player)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Player")
+	proto.RegisterType((*PlayerTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.PlayerTeam")
+	proto.RegisterType((*RosterChange)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.RosterChange")
+	proto.RegisterType((*Round)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Round")
+	proto.RegisterType((*Game)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Game")
+	proto.RegisterType((*GameTeam)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.GameTeam")
+	proto.RegisterType((*Arena)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Arena")
+	proto.RegisterType((*Correction)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Correction")
+	proto.RegisterType((*Official)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Official")
+	proto.RegisterType((*GameOfficial)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.GameOfficial")
+	proto.RegisterType((*Event)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Event")
+	proto.RegisterType((*Command)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Command")
+}
+
+func init() { proto.RegisterFile("models.proto", fileDescriptor_0b5431a010549573) }
+
+var fileDescriptor_0b5431a010549573 = []byte{
+	// 1480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x6e, 0xdb, 0x46,
+	0x13, 0x37, 0x25, 0x8a, 0xa2, 0x46, 0x92, 0xc3, 0x8f, 0x30, 0x0c, 0x7e, 0xfe, 0x12, 0x47, 0x60,
+	0x3e, 0x14, 0xaa, 0xd1, 0x28, 0x40, 0xda, 0x5e, 0x0a, 0xf4, 0x20, 0x4b, 0xb4, 0x41, 0x54, 0x11,
+	0xd5, 0xa5, 0x5c, 0x23, 0xb9, 0x10, 0x94, 0xb8, 0x92, 0x99, 0x88, 0xa4, 0xba, 0xa4, 0x6c, 0x24,
+	0xe7, 0xf4, 0x56, 0xf4, 0xd4, 0x43, 0x6f, 0x05, 0x8a, 0xf6, 0x25, 0xda, 0x17, 0xe8, 0xb1, 0x7d,
+	0x81, 0xa0, 0x48, 0xdf, 0x20, 0x4f, 0x50, 0xec, 0x72, 0x45, 0x49, 0xfe, 0x13, 0x44, 0x76, 0x7a,
+	0xe3, 0x6f, 0x56, 0x3b, 0xfb, 0xdb, 0x99, 0xdf, 0xce, 0x0c, 0x04, 0x95, 0x20, 0xf2, 0xf0, 0x24,
+	0x6e, 0x4c, 0x49, 0x94, 0x44, 0xea, 0xa7, 0x1e, 0x3e, 0x6d, 0x3c, 0x9d, 0xc5, 0x89, 0x1f, 0x3e,
+	0x9d, 0x79, 0x5e, 0x23, 0x22, 0x63, 0x0e, 0x1b, 0xc3, 0x28, 0x98, 0xe2, 0xc4, 0x4f, 0xfc, 0x28,
+	0x6c, 0xf0, 0x3d, 0x71, 0x12, 0x91, 0xa0, 0x31, 0x1d, 0xec, 0xdc, 0x1f, 0xfb, 0xc9, 0xc9, 0x6c,
+	0x40, 0x7f, 0xf3, 0x60, 0x1c, 0x8d, 0xa3, 0x07, 0xcc, 0xdb, 0x60, 0x36, 0x62, 0x88, 0x01, 0xf6,
+	0x95, 0x9e, 0xa2, 0x3f, 0x85, 0x72, 0x6b, 0xe1, 0x4d, 0xad, 0x43, 0xce, 0xf7, 0x34, 0xa1, 0x26,
+	0xd4, 0xc5, 0x7d, 0xed, 0xcd, 0xab, 0xbb, 0x5b, 0xcc, 0xef, 0x67, 0xba, 0xef, 0x7d, 0xe4, 0x87,
+	0x43, 0x82, 0x03, 0x1c, 0x26, 0x3a, 0xca, 0xf9, 0x9e, 0xaa, 0x82, 0x18, 0xba, 0x01, 0xd6, 0x72,
+	0x35, 0xa1, 0x5e, 0x42, 0xec, 0x5b, 0xbd, 0x03, 0x30, 0x89, 0x62, 0x4c, 0x62, 0x87, 0xe0, 0x91,
+	0x96, 0x67, 0x2b, 0xa5, 0xd4, 0x82, 0xf0, 0x48, 0xff, 0x45, 0x80, 0x5b, 0x4b, 0x87, 0xf5, 0xb1,
+	0x1b, 0xac, 0x71, 0xe0, 0xff, 0xa1, 0xba, 0x74, 0x6f, 0xd3, 0x63, 0x27, 0x8b, 0x68, 0xd5, 0xa8,
+	0x6e, 0x83, 0x94, 0x60, 0x37, 0x30, 0x3d, 0x76, 0xbc, 0x88, 0x38, 0x52, 0xb7, 0xa0, 0x10, 0x0f,
+	0x23, 0x82, 0x35, 0xb1, 0x26, 0xd4, 0xf3, 0x28, 0x05, 0xd4, 0x3a, 0x9d, 0xb8, 0x43, 0xac, 0x15,
+	0x52, 0x2b, 0x03, 0xfa, 0xf7, 0x22, 0x40, 0x3f, 0x9a, 0x11, 0x7a, 0xa7, 0x30, 0xb9, 0x61, 0x4c,
+	0x1e, 0x83, 0x98, 0x3c, 0x9f, 0x62, 0x46, 0x67, 0xf3, 0xa1, 0xd1, 0xb8, 0x56, 0x56, 0x1b, 0x0b,
+	0x3a, 0xfd, 0xe7, 0x53, 0x8c, 0x98, 0x4b, 0xf5, 0x08, 0xa4, 0x38, 0x71, 0x93, 0x59, 0xcc, 0x2e,
+	0xb5, 0xf9, 0xf0, 0xf3, 0x6b, 0x3a, 0xb7, 0x99, 0x13, 0xc4, 0x9d, 0x5d, 0x0c, 0x74, 0xe1, 0x8a,
+	0x40, 0xc7, 0x18, 0x7b, 0xd8, 0xd3, 0xa4, 0x9a, 0x50, 0x97, 0x11, 0x47, 0xea, 0xff, 0xa0, 0x34,
+	0x76, 0x03, 0xec, 0xc4, 0xfe, 0x0b, 0xac, 0x15, 0x6b, 0x42, 0xbd, 0x8a, 0x64, 0x6a, 0xb0, 0xfd,
+	0x17, 0x58, 0xbd, 0x0d, 0x25, 0xd7, 0x3b, 0x75, 0xc3, 0xa1, 0x1f, 0x8e, 0x35, 0x99, 0x2d, 0x2e,
+	0x0c, 0xcc, 0x25, 0x4d, 0x8b, 0xa7, 0x95, 0xb8, 0x4b, 0x86, 0xd4, 0x7b, 0x50, 0x1d, 0x10, 0x77,
+	0xf8, 0x0c, 0x27, 0x4e, 0x44, 0x3c, 0x4c, 0x34, 0xa8, 0xe5, 0xeb, 0x25, 0x54, 0xe1, 0x46, 0x8b,
+	0xda, 0xd4, 0x1d, 0x90, 0x03, 0x9c, 0xb8, 0x9e, 0x9b, 0xb8, 0x5a, 0xb9, 0x26, 0xd4, 0x2b, 0x28,
+	0xc3, 0xd4, 0xc1, 0x28, 0x22, 0x23, 0xec, 0x27, 0x4e, 0x2a, 0x82, 0x0a, 0x4b, 0x77, 0x85, 0x1b,
+	0x6d, 0x6a, 0x53, 0xef, 0x42, 0x99, 0x44, 0xb3, 0xd0, 0x73, 0x86, 0xd1, 0x2c, 0x4c, 0xb4, 0x2a,
+	0x63, 0x07, 0xcc, 0xd4, 0xa2, 0x16, 0xfd, 0x14, 0x36, 0x97, 0xd2, 0xb0, 0x9e, 0x78, 0x75, 0xa8,
+	0x24, 0xd9, 0xde, 0x4c, 0xbb, 0x2b, 0xb6, 0xab, 0xa4, 0xab, 0xff, 0x24, 0x80, 0xb8, 0xe6, 0x71,
+	0x97, 0x09, 0xf1, 0x3c, 0x85, 0xfc, 0x25, 0x14, 0x96, 0x83, 0x28, 0x9e, 0x0b, 0xe2, 0x6d, 0x28,
+	0x9d, 0xf9, 0xc9, 0x89, 0x47, 0xdc, 0xb3, 0x90, 0x49, 0x42, 0x46, 0x0b, 0x83, 0xfe, 0xad, 0x00,
+	0x52, 0x6f, 0xe2, 0x3e, 0xc7, 0x64, 0x0d, 0x9a, 0x1f, 0x2c, 0xd3, 0xdc, 0x57, 0xdf, 0xbc, 0xba,
+	0xbb, 0xc9, 0x7f, 0x3b, 0x0b, 0xfd, 0xaf, 0x67, 0x58, 0xe7, 0xd4, 0xb3, 0xc7, 0x9b, 0x72, 0x4e,
+	0xc1, 0xdb, 0xc8, 0xea, 0x2f, 0x05, 0x80, 0x94, 0xce, 0x9a, 0x91, 0xdb, 0x01, 0x79, 0xca, 0xf6,
+	0x65, 0x49, 0xca, 0xf0, 0x95, 0xb5, 0x45, 0x83, 0x22, 0xc1, 0x41, 0x74, 0x8a, 0x3d, 0xc6, 0x43,
+	0x46, 0x73, 0xa8, 0xff, 0x2a, 0x40, 0x05, 0x45, 0x71, 0x82, 0x49, 0xeb, 0xc4, 0x0d, 0xc7, 0x78,
+	0x0d, 0x22, 0x8b, 0xc3, 0x72, 0x2b, 0x87, 0x2d, 0x13, 0xcc, 0x9f, 0x23, 0xb8, 0x05, 0x05, 0xd7,
+	0xf3, 0x32, 0x1a, 0x29, 0xa0, 0x89, 0x4b, 0xfc, 0x00, 0xc7, 0x89, 0x1b, 0x4c, 0x79, 0xa1, 0x5b,
+	0x18, 0x28, 0xf9, 0x61, 0x14, 0x04, 0x6e, 0x98, 0x3e, 0x64, 0x11, 0xcd, 0xa1, 0xfe, 0xa7, 0x00,
+	0x05, 0x44, 0xe5, 0xbf, 0x06, 0xeb, 0x45, 0x49, 0xca, 0xbd, 0xcf, 0x92, 0xf4, 0x2e, 0xda, 0xbd,
+	0x07, 0xd5, 0x18, 0x13, 0x1f, 0xc7, 0xce, 0x04, 0x87, 0xe3, 0xe4, 0x84, 0x05, 0xa1, 0x8a, 0x2a,
+	0xa9, 0xb1, 0xc3, 0x6c, 0xfa, 0x77, 0x22, 0x88, 0x87, 0x54, 0x52, 0xef, 0x7e, 0x25, 0x0d, 0x8a,
+	0x2e, 0xc1, 0xa1, 0xcb, 0x33, 0x51, 0x45, 0x73, 0xc8, 0xf2, 0x4e, 0xe3, 0x93, 0x11, 0x9a, 0xc3,
+	0x7f, 0xab, 0x32, 0x6b, 0x50, 0xe4, 0x35, 0x8f, 0xe5, 0xb1, 0x84, 0xe6, 0xf0, 0xe2, 0xe5, 0xa5,
+	0x8b, 0x97, 0x67, 0xd2, 0x71, 0x49, 0x1a, 0xc1, 0x22, 0x97, 0x0e, 0xc7, 0xea, 0x00, 0xca, 0x53,
+	0x4c, 0xfc, 0xc8, 0x73, 0x58, 0xb7, 0x92, 0x19, 0xed, 0xe6, 0x35, 0x69, 0xf7, 0x98, 0x27, 0xd6,
+	0xa9, 0x60, 0x9a, 0x7d, 0xab, 0xf7, 0x41, 0x25, 0x78, 0x3c, 0x9b, 0xb8, 0x74, 0x93, 0x93, 0x2e,
+	0xc4, 0xac, 0xd6, 0x57, 0xd1, 0x7f, 0x16, 0x2b, 0xe9, 0xee, 0x98, 0x4e, 0x13, 0x71, 0xe2, 0x92,
+	0xc4, 0xa1, 0x62, 0xd5, 0x20, 0x15, 0x2e, 0xb3, 0xf4, 0xfd, 0x00, 0xab, 0xff, 0x05, 0x19, 0x87,
+	0x5e, 0xba, 0x58, 0x66, 0x8b, 0x45, 0x1c, 0x7a, 0x6c, 0xe9, 0x43, 0x50, 0x46, 0x7e, 0xe8, 0xc7,
+	0x27, 0xd8, 0x73, 0xb8, 0x9a, 0x59, 0xc9, 0x17, 0xd1, 0xad, 0xb9, 0xbd, 0xc5, 0x45, 0xfe, 0x73,
+	0x0e, 0x64, 0x2a, 0x88, 0x35, 0xcb, 0xc4, 0x36, 0x48, 0xb4, 0xa9, 0x2d, 0x5e, 0x67, 0x8a, 0xde,
+	0xc7, 0xf8, 0x41, 0x73, 0xc9, 0x53, 0xc1, 0x7e, 0x15, 0x6b, 0x52, 0x2d, 0x4f, 0xbb, 0x55, 0x6a,
+	0x64, 0xcd, 0x2a, 0xa6, 0x0a, 0x23, 0x38, 0x9e, 0x4d, 0x12, 0xad, 0x78, 0x23, 0x85, 0x21, 0xe6,
+	0x04, 0x71, 0x67, 0x94, 0x3f, 0xc1, 0x6e, 0x1c, 0x85, 0x4c, 0x01, 0x25, 0xc4, 0x91, 0xfe, 0x0c,
+	0x0a, 0x4d, 0xaa, 0xfa, 0xa5, 0x10, 0x55, 0xaf, 0xd1, 0x83, 0x2e, 0x8c, 0x16, 0xf9, 0x4b, 0x46,
+	0x0b, 0xfd, 0x65, 0x0e, 0xa0, 0x15, 0x11, 0x82, 0x87, 0x6b, 0xce, 0xa4, 0x57, 0x65, 0x65, 0x07,
+	0xe4, 0x68, 0x34, 0xf2, 0x87, 0xbe, 0x3b, 0xe1, 0x53, 0x69, 0x86, 0x57, 0xab, 0xa3, 0x78, 0xbe,
+	0x3a, 0xde, 0x01, 0x88, 0x26, 0x59, 0x22, 0x0a, 0x2c, 0x11, 0xa5, 0x68, 0x32, 0xcf, 0xc2, 0x1d,
+	0x80, 0x10, 0x9f, 0xad, 0xe6, 0xa9, 0x14, 0xe2, 0xb3, 0xc5, 0x32, 0xdd, 0xcd, 0xd2, 0x1a, 0x6b,
+	0xc5, 0x6c, 0x77, 0x8f, 0x19, 0xe6, 0xbb, 0xf9, 0xb2, 0x9c, 0xed, 0x4e, 0x97, 0x69, 0xdf, 0x97,
+	0xad, 0x39, 0xcd, 0x9b, 0xf5, 0xfe, 0x77, 0x8a, 0xfb, 0x4a, 0x6b, 0x11, 0xaf, 0xec, 0x7d, 0x85,
+	0x95, 0xe1, 0xe4, 0xa5, 0x00, 0x15, 0xfa, 0x7e, 0xae, 0x41, 0xf4, 0xaa, 0x6c, 0xed, 0x02, 0xcc,
+	0xb3, 0x93, 0x31, 0x5d, 0xb2, 0xd0, 0x0b, 0x92, 0x68, 0x92, 0x3e, 0xa5, 0x12, 0x62, 0xdf, 0xfa,
+	0x8f, 0x39, 0x28, 0x18, 0xa7, 0xeb, 0x4d, 0xeb, 0x4f, 0x40, 0x72, 0x99, 0xc2, 0x78, 0xaf, 0xda,
+	0xbf, 0xe6, 0x13, 0x62, 0xe7, 0x36, 0x99, 0x27, 0xc4, 0x3d, 0x52, 0x8e, 0xcf, 0xfc, 0xd0, 0xe3,
+	0x6a, 0x63, 0xdf, 0x34, 0xbc, 0x04, 0x0f, 0x23, 0xe2, 0x2d, 0xc2, 0x3b, 0xc7, 0xb4, 0x12, 0x8c,
+	0x7c, 0x3c, 0xf1, 0x78, 0x5d, 0x4f, 0x01, 0xf5, 0xc2, 0xa6, 0x1b, 0x89, 0x4d, 0x37, 0xe2, 0x7c,
+	0x0c, 0x5b, 0xe8, 0xb5, 0xf8, 0x96, 0x6e, 0x2e, 0xaf, 0x76, 0xf3, 0xdf, 0x04, 0x28, 0xf2, 0xa2,
+	0x77, 0x43, 0x31, 0xad, 0x30, 0xc8, 0x9f, 0x67, 0xb0, 0x0d, 0xd2, 0x2c, 0xf4, 0xa2, 0x10, 0xf3,
+	0x21, 0x84, 0x23, 0x6a, 0x4f, 0x5c, 0x32, 0xc6, 0x49, 0x26, 0x20, 0x86, 0x2e, 0x4a, 0x53, 0xba,
+	0x44, 0x9a, 0x7b, 0xf7, 0x41, 0x4a, 0x7b, 0xa1, 0x5a, 0x84, 0x7c, 0xd7, 0x38, 0x56, 0x36, 0xd4,
+	0x32, 0x14, 0xad, 0xee, 0xa1, 0x65, 0x76, 0x0f, 0x15, 0x41, 0xad, 0x42, 0xa9, 0x65, 0x3d, 0xea,
+	0x75, 0x8c, 0xbe, 0xd1, 0x56, 0x72, 0x7b, 0xdf, 0x08, 0x2b, 0xb3, 0x3a, 0x6d, 0x3e, 0xdb, 0xa0,
+	0xda, 0x66, 0xf7, 0xb0, 0x63, 0x38, 0x46, 0xc7, 0x7c, 0x64, 0x76, 0x9b, 0x7d, 0xd3, 0xea, 0x2a,
+	0x1b, 0xd4, 0xde, 0xb6, 0x8e, 0xf6, 0xcf, 0xd9, 0x05, 0xf5, 0x16, 0x94, 0x91, 0x75, 0xd4, 0x6d,
+	0x3b, 0xc8, 0xda, 0x37, 0xbb, 0x4a, 0x4e, 0x55, 0xa0, 0x42, 0x8f, 0x68, 0xda, 0xb6, 0xd3, 0x46,
+	0xcd, 0x63, 0x25, 0x4f, 0x2d, 0xf6, 0xb1, 0x69, 0xdb, 0xce, 0x81, 0x85, 0x1e, 0x35, 0xfb, 0x8a,
+	0xa8, 0x6e, 0x02, 0x1c, 0x22, 0xeb, 0xa8, 0xe7, 0xf4, 0x3a, 0xcd, 0xc7, 0x4a, 0x61, 0x4f, 0x07,
+	0x58, 0xf4, 0x42, 0x15, 0x40, 0xea, 0x59, 0x66, 0xb7, 0x6f, 0x2b, 0x1b, 0xaa, 0x0c, 0xa2, 0x6d,
+	0xf4, 0x6d, 0x45, 0xd8, 0x73, 0x41, 0x4a, 0x8b, 0x30, 0xbd, 0xc4, 0x51, 0xb7, 0x6d, 0xb4, 0xcc,
+	0xb6, 0xd1, 0x56, 0x36, 0xe8, 0x4d, 0x8f, 0x4d, 0x4a, 0x45, 0x06, 0xb1, 0x63, 0xd9, 0xb6, 0x92,
+	0xa3, 0x5f, 0xfc, 0xec, 0x32, 0x14, 0x0f, 0x2c, 0x74, 0x60, 0x98, 0xf4, 0x58, 0x05, 0x2a, 0x6d,
+	0xd3, 0xfe, 0xf2, 0xa8, 0xd9, 0x31, 0x0f, 0x4c, 0xa3, 0xad, 0x14, 0xd4, 0x0a, 0xc8, 0xc7, 0xcd,
+	0xce, 0x17, 0xd6, 0x57, 0x06, 0x52, 0xa4, 0xbd, 0x4f, 0xa0, 0xbc, 0x24, 0x52, 0xba, 0xb7, 0x85,
+	0x8c, 0x66, 0x9f, 0x9d, 0x52, 0x86, 0xe2, 0x51, 0xaf, 0xcd, 0x80, 0x40, 0x41, 0xdb, 0xe0, 0x41,
+	0xdc, 0xdf, 0xfa, 0xfd, 0xf5, 0xae, 0xf0, 0xc7, 0xeb, 0x5d, 0xe1, 0xaf, 0xd7, 0xbb, 0xc2, 0x0f,
+	0x7f, 0xef, 0x6e, 0x3c, 0xc9, 0x4d, 0x07, 0x03, 0x89, 0xfd, 0x6f, 0xf0, 0xf1, 0x3f, 0x03, 0x00,
+	0x2f, 0x91, 0xbc, 0xd6, 0xad, 0x10, 0x00, 0x00,
+}
+
+func (m *Competition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1258,12 +1786,12 @@ func (m *Player) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Player) MarshalTo(dAtA []byte) (int, error) {
+func (m *Competition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Player) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Competition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1272,17 +1800,12 @@ func (m *Player) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Score != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Score))
+	if len(m.LosersRef) > 0 {
+		i -= len(m.LosersRef)
+		copy(dAtA[i:], m.LosersRef)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.LosersRef)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -1299,7 +1822,7 @@ func (m *Player) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PlayerTeam) Marshal() (dAtA []byte, err error) {
+func (m *CompetitionTeam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1309,12 +1832,12 @@ func (m *PlayerTeam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PlayerTeam) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompetitionTeam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PlayerTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CompetitionTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1323,13 +1846,23 @@ func (m *PlayerTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Place != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Place))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Score != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TeamId != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.PlayerId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.PlayerId))
+	if m.CompetitionId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -1341,7 +1874,7 @@ func (m *PlayerTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Round) Marshal() (dAtA []byte, err error) {
+func (m *Tournament) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1351,12 +1884,12 @@ func (m *Round) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Round) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tournament) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Round) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tournament) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1365,25 +1898,93 @@ func (m *Round) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TournamentId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TournamentId))
+	if m.RoundCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RoundCount))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x68
 	}
-	if m.Status != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+	if m.ForfeitScore != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ForfeitScore))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x60
 	}
-	if m.Id != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Game) Marshal() (dAtA []byte, err error) {
+	if len(m.BracketOrder) > 0 {
+		for iNdEx := len(m.BracketOrder) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BracketOrder[iNdEx])
+			copy(dAtA[i:], m.BracketOrder[iNdEx])
+			i = encodeVarintModels(dAtA, i, uint64(len(m.BracketOrder[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Scored {
+		i--
+		if m.Scored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Advancing != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Advancing))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GameSize != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GameSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Seeded {
+		i--
+		if m.Seeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CompetitionId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TournamentTeam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1393,12 +1994,12 @@ func (m *Game) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Game) MarshalTo(dAtA []byte) (int, error) {
+func (m *TournamentTeam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Game) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TournamentTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1407,25 +2008,13 @@ func (m *Game) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Bracket) > 0 {
-		i -= len(m.Bracket)
-		copy(dAtA[i:], m.Bracket)
-		i = encodeVarintModels(dAtA, i, uint64(len(m.Bracket)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Status != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RoundId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.RoundId))
+	if m.TeamId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.ArenaId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.ArenaId))
+	if m.TournamentId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TournamentId))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -1437,7 +2026,7 @@ func (m *Game) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GameTeam) Marshal() (dAtA []byte, err error) {
+func (m *Team) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1447,12 +2036,12 @@ func (m *GameTeam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GameTeam) MarshalTo(dAtA []byte) (int, error) {
+func (m *Team) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GameTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Team) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1461,25 +2050,34 @@ func (m *GameTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Place != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Place))
+	if m.Withdrawn {
+		i--
+		if m.Withdrawn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x28
 	}
-	if m.Score != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.Score))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.TeamId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
+	if m.TournamentId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TournamentId))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.GameId != 0 {
-		i = encodeVarintModels(dAtA, i, uint64(m.GameId))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.Id != 0 {
 		i = encodeVarintModels(dAtA, i, uint64(m.Id))
@@ -1489,7 +2087,7 @@ func (m *GameTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Arena) Marshal() (dAtA []byte, err error) {
+func (m *Player) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1499,12 +2097,12 @@ func (m *Arena) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Arena) MarshalTo(dAtA []byte) (int, error) {
+func (m *Player) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Arena) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Player) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1513,6 +2111,18 @@ func (m *Arena) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Score != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -1528,309 +2138,2522 @@ func (m *Arena) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
-	offset -= sovModels(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PlayerTeam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Competition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *PlayerTeam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CompetitionTeam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PlayerTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CompetitionId != 0 {
-		n += 1 + sovModels(uint64(m.CompetitionId))
+	if m.Removed {
+		i--
+		if m.Removed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.TeamId != 0 {
-		n += 1 + sovModels(uint64(m.TeamId))
-	}
-	if m.Score != 0 {
-		n += 1 + sovModels(uint64(m.Score))
+		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Place != 0 {
-		n += 1 + sovModels(uint64(m.Place))
+	if m.PlayerId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PlayerId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Tournament) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RosterChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RosterChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RosterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Command != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Command))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Type != 0 {
-		n += 1 + sovModels(uint64(m.Type))
+	if m.Timestamp != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Status != 0 {
-		n += 1 + sovModels(uint64(m.Status))
-	}
-	if m.CompetitionId != 0 {
-		n += 1 + sovModels(uint64(m.CompetitionId))
-	}
-	if m.Seeded {
-		n += 2
-	}
-	if m.GameSize != 0 {
-		n += 1 + sovModels(uint64(m.GameSize))
-	}
-	if m.Advancing != 0 {
-		n += 1 + sovModels(uint64(m.Advancing))
-	}
-	if m.Scored {
-		n += 2
-	}
-	if len(m.BracketOrder) > 0 {
-		for _, s := range m.BracketOrder {
-			l = len(s)
-			n += 1 + l + sovModels(uint64(l))
+	if m.Added {
+		i--
+		if m.Added {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *TournamentTeam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	if m.TournamentId != 0 {
-		n += 1 + sovModels(uint64(m.TournamentId))
+	if m.PlayerId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PlayerId))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.TeamId != 0 {
-		n += 1 + sovModels(uint64(m.TeamId))
+		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Team) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Round) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Round) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Round) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.SeriesLength != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SeriesLength))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.TournamentId != 0 {
-		n += 1 + sovModels(uint64(m.TournamentId))
+		i = encodeVarintModels(dAtA, i, uint64(m.TournamentId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Player) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Game) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Game) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Game) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.Score != 0 {
-		n += 1 + sovModels(uint64(m.Score))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *PlayerTeam) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FinishedCommand != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.FinishedCommand))
+		i--
+		dAtA[i] = 0x60
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
+	if m.EndTime != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.PlayerId != 0 {
-		n += 1 + sovModels(uint64(m.PlayerId))
+	if m.StartTime != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.TeamId != 0 {
-		n += 1 + sovModels(uint64(m.TeamId))
+	if m.RegulationPeriods != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RegulationPeriods))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PeriodType != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PeriodType))
+		i--
+		dAtA[i] = 0x40
 	}
-	return n
-}
-
-func (m *Round) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ParentId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ParentId))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
+	if m.SeriesLength != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.SeriesLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Bracket) > 0 {
+		i -= len(m.Bracket)
+		copy(dAtA[i:], m.Bracket)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Bracket)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.Status != 0 {
-		n += 1 + sovModels(uint64(m.Status))
+		i = encodeVarintModels(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TournamentId != 0 {
-		n += 1 + sovModels(uint64(m.TournamentId))
+	if m.RoundId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ArenaId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ArenaId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Game) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GameTeam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GameTeam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameTeam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ArenaId != 0 {
-		n += 1 + sovModels(uint64(m.ArenaId))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.RoundId != 0 {
-		n += 1 + sovModels(uint64(m.RoundId))
+	if m.Result != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Status != 0 {
-		n += 1 + sovModels(uint64(m.Status))
+	if len(m.PeriodScores) > 0 {
+		dAtA2 := make([]byte, len(m.PeriodScores)*10)
+		var j1 int
+		for _, num1 := range m.PeriodScores {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintModels(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Bracket)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
+	if m.Place != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Place))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Score != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TeamId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GameId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Arena) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Arena) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Arena) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CompetitionId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Correction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Correction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Correction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewPlaces) > 0 {
+		dAtA4 := make([]byte, len(m.NewPlaces)*10)
+		var j3 int
+		for _, num1 := range m.NewPlaces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintModels(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OldPlaces) > 0 {
+		dAtA6 := make([]byte, len(m.OldPlaces)*10)
+		var j5 int
+		for _, num1 := range m.OldPlaces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintModels(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NewScores) > 0 {
+		dAtA8 := make([]byte, len(m.NewScores)*10)
+		var j7 int
+		for _, num1 := range m.NewScores {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintModels(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OldScores) > 0 {
+		dAtA10 := make([]byte, len(m.OldScores)*10)
+		var j9 int
+		for _, num1 := range m.OldScores {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintModels(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Official) > 0 {
+		i -= len(m.Official)
+		copy(dAtA[i:], m.Official)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Official)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GameId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Official) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Official) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Official) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TeamId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.TeamId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PlayerId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.PlayerId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompetitionId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameOfficial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameOfficial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameOfficial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OfficialId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.OfficialId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GameId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.GameId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Command != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Command))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Action != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Command) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Command) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Command) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CompetitionId != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CompetitionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Target != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Target))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Undone {
+		i--
+		if m.Undone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Competition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.LosersRef)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompetitionTeam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.CompetitionId != 0 {
+		n += 1 + sovModels(uint64(m.CompetitionId))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovModels(uint64(m.TeamId))
+	}
+	if m.Score != 0 {
+		n += 1 + sovModels(uint64(m.Score))
+	}
+	if m.Place != 0 {
+		n += 1 + sovModels(uint64(m.Place))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tournament) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovModels(uint64(m.Type))
+	}
+	if m.Status != 0 {
+		n += 1 + sovModels(uint64(m.Status))
+	}
+	if m.CompetitionId != 0 {
+		n += 1 + sovModels(uint64(m.CompetitionId))
+	}
+	if m.Seeded {
+		n += 2
+	}
+	if m.GameSize != 0 {
+		n += 1 + sovModels(uint64(m.GameSize))
+	}
+	if m.Advancing != 0 {
+		n += 1 + sovModels(uint64(m.Advancing))
+	}
+	if m.Scored {
+		n += 2
+	}
+	if len(m.BracketOrder) > 0 {
+		for _, s := range m.BracketOrder {
+			l = len(s)
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.ForfeitScore != 0 {
+		n += 1 + sovModels(uint64(m.ForfeitScore))
+	}
+	if m.RoundCount != 0 {
+		n += 1 + sovModels(uint64(m.RoundCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TournamentTeam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.TournamentId != 0 {
+		n += 1 + sovModels(uint64(m.TournamentId))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovModels(uint64(m.TeamId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Team) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.TournamentId != 0 {
+		n += 1 + sovModels(uint64(m.TournamentId))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Withdrawn {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Player) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovModels(uint64(m.Score))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlayerTeam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.PlayerId != 0 {
+		n += 1 + sovModels(uint64(m.PlayerId))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovModels(uint64(m.TeamId))
+	}
+	if m.Removed {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RosterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovModels(uint64(m.TeamId))
+	}
+	if m.PlayerId != 0 {
+		n += 1 + sovModels(uint64(m.PlayerId))
+	}
+	if m.Added {
+		n += 2
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovModels(uint64(m.Timestamp))
+	}
+	if m.Command != 0 {
+		n += 1 + sovModels(uint64(m.Command))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Round) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.Status != 0 {
+		n += 1 + sovModels(uint64(m.Status))
+	}
+	if m.TournamentId != 0 {
+		n += 1 + sovModels(uint64(m.TournamentId))
+	}
+	if m.SeriesLength != 0 {
+		n += 1 + sovModels(uint64(m.SeriesLength))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Game) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.ArenaId != 0 {
+		n += 1 + sovModels(uint64(m.ArenaId))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovModels(uint64(m.RoundId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovModels(uint64(m.Status))
+	}
+	l = len(m.Bracket)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.SeriesLength != 0 {
+		n += 1 + sovModels(uint64(m.SeriesLength))
+	}
+	if m.ParentId != 0 {
+		n += 1 + sovModels(uint64(m.ParentId))
+	}
+	if m.PeriodType != 0 {
+		n += 1 + sovModels(uint64(m.PeriodType))
+	}
+	if m.RegulationPeriods != 0 {
+		n += 1 + sovModels(uint64(m.RegulationPeriods))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovModels(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovModels(uint64(m.EndTime))
+	}
+	if m.FinishedCommand != 0 {
+		n += 1 + sovModels(uint64(m.FinishedCommand))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GameTeam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovModels(uint64(m.GameId))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovModels(uint64(m.TeamId))
+	}
+	if m.Score != 0 {
+		n += 1 + sovModels(uint64(m.Score))
+	}
+	if m.Place != 0 {
+		n += 1 + sovModels(uint64(m.Place))
+	}
+	if len(m.PeriodScores) > 0 {
+		l = 0
+		for _, e := range m.PeriodScores {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if m.Result != 0 {
+		n += 1 + sovModels(uint64(m.Result))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Arena) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.CompetitionId != 0 {
+		n += 1 + sovModels(uint64(m.CompetitionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Correction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovModels(uint64(m.GameId))
+	}
+	l = len(m.Official)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovModels(uint64(m.Timestamp))
+	}
+	if len(m.OldScores) > 0 {
+		l = 0
+		for _, e := range m.OldScores {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if len(m.NewScores) > 0 {
+		l = 0
+		for _, e := range m.NewScores {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if len(m.OldPlaces) > 0 {
+		l = 0
+		for _, e := range m.OldPlaces {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if len(m.NewPlaces) > 0 {
+		l = 0
+		for _, e := range m.NewPlaces {
+			l += sovModels(uint64(e))
+		}
+		n += 1 + sovModels(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Official) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.CompetitionId != 0 {
+		n += 1 + sovModels(uint64(m.CompetitionId))
+	}
+	if m.PlayerId != 0 {
+		n += 1 + sovModels(uint64(m.PlayerId))
+	}
+	if m.TeamId != 0 {
+		n += 1 + sovModels(uint64(m.TeamId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GameOfficial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.GameId != 0 {
+		n += 1 + sovModels(uint64(m.GameId))
+	}
+	if m.OfficialId != 0 {
+		n += 1 + sovModels(uint64(m.OfficialId))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovModels(uint64(m.Action))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovModels(uint64(m.RecordId))
+	}
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovModels(uint64(m.Timestamp))
+	}
+	if m.Command != 0 {
+		n += 1 + sovModels(uint64(m.Command))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Command) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovModels(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovModels(uint64(m.Timestamp))
+	}
+	if m.Undone {
+		n += 2
+	}
+	if m.Target != 0 {
+		n += 1 + sovModels(uint64(m.Target))
+	}
+	if m.CompetitionId != 0 {
+		n += 1 + sovModels(uint64(m.CompetitionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovModels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModels(x uint64) (n int) {
+	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Competition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Competition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Competition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LosersRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LosersRef = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompetitionTeam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompetitionTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
+			}
+			m.CompetitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompetitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			}
+			m.TeamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Place", wireType)
+			}
+			m.Place = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Place |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tournament) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tournament: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tournament: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TournamentType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
+			}
+			m.CompetitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompetitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Seeded = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameSize", wireType)
+			}
+			m.GameSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advancing", wireType)
+			}
+			m.Advancing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Advancing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scored = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BracketOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BracketOrder = append(m.BracketOrder, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitScore", wireType)
+			}
+			m.ForfeitScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForfeitScore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundCount", wireType)
+			}
+			m.RoundCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentTeam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+			}
+			m.TournamentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TournamentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			}
+			m.TeamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Team) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Team: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Team: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+			}
+			m.TournamentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TournamentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Withdrawn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Player) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Player: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Player: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.Metadata == nil {
+				m.Metadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *GameTeam) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	if m.GameId != 0 {
-		n += 1 + sovModels(uint64(m.GameId))
-	}
-	if m.TeamId != 0 {
-		n += 1 + sovModels(uint64(m.TeamId))
-	}
-	if m.Score != 0 {
-		n += 1 + sovModels(uint64(m.Score))
-	}
-	if m.Place != 0 {
-		n += 1 + sovModels(uint64(m.Place))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *Arena) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovModels(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovModels(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *PlayerTeam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlayerTeam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlayerTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerId", wireType)
+			}
+			m.PlayerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlayerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			}
+			m.TeamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Removed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovModels(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozModels(x uint64) (n int) {
-	return sovModels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Competition) Unmarshal(dAtA []byte) error {
+func (m *RosterChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,17 +4676,94 @@ func (m *Competition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Competition: wiretype end group for non-group")
+			return fmt.Errorf("proto: RosterChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Competition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RosterChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			}
+			m.TeamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerId", wireType)
+			}
+			m.PlayerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlayerId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Id = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1873,16 +4773,16 @@ func (m *Competition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var stringLen uint64
+			m.Command = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1892,24 +4792,11 @@ func (m *Competition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Command |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -1935,7 +4822,7 @@ func (m *Competition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
+func (m *Round) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1958,10 +4845,10 @@ func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompetitionTeam: wiretype end group for non-group")
+			return fmt.Errorf("proto: Round: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompetitionTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Round: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1985,9 +4872,9 @@ func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.CompetitionId = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -1997,16 +4884,16 @@ func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompetitionId |= uint64(b&0x7F) << shift
+				m.Status |= Status(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
 			}
-			m.TeamId = 0
+			m.TournamentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2016,35 +4903,16 @@ func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TeamId |= uint64(b&0x7F) << shift
+				m.TournamentId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
-			}
-			m.Score = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Score |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Place", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesLength", wireType)
 			}
-			m.Place = 0
+			m.SeriesLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2054,7 +4922,7 @@ func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Place |= int64(b&0x7F) << shift
+				m.SeriesLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2084,7 +4952,7 @@ func (m *CompetitionTeam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tournament) Unmarshal(dAtA []byte) error {
+func (m *Game) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2107,10 +4975,10 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tournament: wiretype end group for non-group")
+			return fmt.Errorf("proto: Game: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tournament: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Game: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2133,10 +5001,10 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArenaId", wireType)
 			}
-			var stringLen uint64
+			m.ArenaId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2146,29 +5014,16 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ArenaId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
 			}
-			m.Type = 0
+			m.RoundId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2178,7 +5033,7 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= TournamentType(b&0x7F) << shift
+				m.RoundId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2203,10 +5058,10 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bracket", wireType)
 			}
-			m.CompetitionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2216,16 +5071,29 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompetitionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bracket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesLength", wireType)
 			}
-			var v int
+			m.SeriesLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2235,17 +5103,16 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SeriesLength |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Seeded = bool(v != 0)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
-			m.GameSize = 0
+			m.ParentId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2255,16 +5122,16 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GameSize |= uint32(b&0x7F) << shift
+				m.ParentId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Advancing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodType", wireType)
 			}
-			m.Advancing = 0
+			m.PeriodType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2274,16 +5141,16 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Advancing |= uint32(b&0x7F) << shift
+				m.PeriodType |= PeriodType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scored", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegulationPeriods", wireType)
 			}
-			var v int
+			m.RegulationPeriods = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2293,17 +5160,16 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RegulationPeriods |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Scored = bool(v != 0)
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BracketOrder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2313,29 +5179,16 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BracketOrder = append(m.BracketOrder, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var byteLen int
+			m.EndTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2345,26 +5198,30 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.EndTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedCommand", wireType)
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+			m.FinishedCommand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedCommand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2390,7 +5247,7 @@ func (m *Tournament) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
+func (m *GameTeam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2400,30 +5257,106 @@ func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowModels
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameTeam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			}
+			m.TeamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TournamentTeam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TournamentTeam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Place", wireType)
 			}
-			m.Id = 0
+			m.Place = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2433,16 +5366,92 @@ func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Place |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PeriodScores = append(m.PeriodScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PeriodScores) == 0 {
+					m.PeriodScores = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PeriodScores = append(m.PeriodScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodScores", wireType)
+			}
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.TournamentId = 0
+			m.Result = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2452,16 +5461,16 @@ func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TournamentId |= uint64(b&0x7F) << shift
+				m.Result |= Result(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.TeamId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2471,11 +5480,24 @@ func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TeamId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2501,7 +5523,7 @@ func (m *TournamentTeam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Team) Unmarshal(dAtA []byte) error {
+func (m *Arena) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2524,10 +5546,10 @@ func (m *Team) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Team: wiretype end group for non-group")
+			return fmt.Errorf("proto: Arena: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Team: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Arena: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2544,7 +5566,7 @@ func (m *Team) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.Id |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2583,28 +5605,9 @@ func (m *Team) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
-			}
-			m.TournamentId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TournamentId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
 			}
-			var byteLen int
+			m.CompetitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2614,26 +5617,11 @@ func (m *Team) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.CompetitionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2659,7 +5647,7 @@ func (m *Team) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Player) Unmarshal(dAtA []byte) error {
+func (m *Correction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2682,10 +5670,10 @@ func (m *Player) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Player: wiretype end group for non-group")
+			return fmt.Errorf("proto: Correction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Player: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Correction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2708,8 +5696,27 @@ func (m *Player) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+			}
+			m.GameId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Official", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2737,13 +5744,13 @@ func (m *Player) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Official = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Score = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2753,45 +5760,315 @@ func (m *Player) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldScores = append(m.OldScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldScores) == 0 {
+					m.OldScores = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldScores = append(m.OldScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldScores", wireType)
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewScores = append(m.NewScores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewScores) == 0 {
+					m.NewScores = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewScores = append(m.NewScores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewScores", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.OldPlaces = append(m.OldPlaces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.OldPlaces) == 0 {
+					m.OldPlaces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.OldPlaces = append(m.OldPlaces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPlaces", wireType)
 			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
+		case 8:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPlaces = append(m.NewPlaces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowModels
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthModels
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthModels
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.NewPlaces) == 0 {
+					m.NewPlaces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowModels
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPlaces = append(m.NewPlaces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPlaces", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -2817,7 +6094,7 @@ func (m *Player) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlayerTeam) Unmarshal(dAtA []byte) error {
+func (m *Official) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2840,10 +6117,10 @@ func (m *PlayerTeam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PlayerTeam: wiretype end group for non-group")
+			return fmt.Errorf("proto: Official: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlayerTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Official: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2866,29 +6143,10 @@ func (m *PlayerTeam) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlayerId", wireType)
-			}
-			m.PlayerId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PlayerId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.TeamId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2898,70 +6156,29 @@ func (m *PlayerTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TeamId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Round) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Round: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Round: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
 			}
-			m.Id = 0
+			m.CompetitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2971,16 +6188,16 @@ func (m *Round) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CompetitionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerId", wireType)
 			}
-			m.Status = 0
+			m.PlayerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -2990,16 +6207,16 @@ func (m *Round) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
+				m.PlayerId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TournamentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
 			}
-			m.TournamentId = 0
+			m.TeamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3009,7 +6226,7 @@ func (m *Round) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TournamentId |= uint64(b&0x7F) << shift
+				m.TeamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3039,7 +6256,7 @@ func (m *Round) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Game) Unmarshal(dAtA []byte) error {
+func (m *GameOfficial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3062,10 +6279,10 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Game: wiretype end group for non-group")
+			return fmt.Errorf("proto: GameOfficial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Game: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GameOfficial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3089,9 +6306,9 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArenaId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
 			}
-			m.ArenaId = 0
+			m.GameId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3101,16 +6318,16 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ArenaId |= uint32(b&0x7F) << shift
+				m.GameId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfficialId", wireType)
 			}
-			m.RoundId = 0
+			m.OfficialId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3120,33 +6337,14 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RoundId |= uint64(b&0x7F) << shift
+				m.OfficialId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bracket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3174,7 +6372,7 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bracket = string(dAtA[iNdEx:postIndex])
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3201,7 +6399,7 @@ func (m *Game) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GameTeam) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3224,10 +6422,10 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GameTeam: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GameTeam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3251,9 +6449,9 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GameId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			m.GameId = 0
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3263,16 +6461,16 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GameId |= uint64(b&0x7F) << shift
+				m.Action |= EventAction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeamId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.TeamId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3282,16 +6480,29 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TeamId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
 			}
-			m.Score = 0
+			m.RecordId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3301,16 +6512,82 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Score |= int64(b&0x7F) << shift
+				m.RecordId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Place", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Place = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -3320,7 +6597,26 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Place |= int64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			m.Command = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Command |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3350,7 +6646,7 @@ func (m *GameTeam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Arena) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3373,10 +6669,10 @@ func (m *Arena) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Arena: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Arena: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3393,7 +6689,7 @@ func (m *Arena) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3430,6 +6726,83 @@ func (m *Arena) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Undone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Undone = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			m.Target = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Target |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompetitionId", wireType)
+			}
+			m.CompetitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompetitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -0,0 +1,4558 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: service.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type TournamentRef struct {
+	Competition          string   `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	Tournament           string   `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TournamentRef) Reset()         { *m = TournamentRef{} }
+func (m *TournamentRef) String() string { return proto.CompactTextString(m) }
+func (*TournamentRef) ProtoMessage()    {}
+func (*TournamentRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{0}
+}
+func (m *TournamentRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentRef.Merge(m, src)
+}
+func (m *TournamentRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentRef proto.InternalMessageInfo
+
+func (m *TournamentRef) GetCompetition() string {
+	if m != nil {
+		return m.Competition
+	}
+	return ""
+}
+
+func (m *TournamentRef) GetTournament() string {
+	if m != nil {
+		return m.Tournament
+	}
+	return ""
+}
+
+type GameRef struct {
+	Competition          string   `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	Tournament           string   `protobuf:"bytes,2,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Round                uint32   `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Game                 uint32   `protobuf:"varint,4,opt,name=game,proto3" json:"game,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameRef) Reset()         { *m = GameRef{} }
+func (m *GameRef) String() string { return proto.CompactTextString(m) }
+func (*GameRef) ProtoMessage()    {}
+func (*GameRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{1}
+}
+func (m *GameRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameRef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameRef.Merge(m, src)
+}
+func (m *GameRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameRef proto.InternalMessageInfo
+
+func (m *GameRef) GetCompetition() string {
+	if m != nil {
+		return m.Competition
+	}
+	return ""
+}
+
+func (m *GameRef) GetTournament() string {
+	if m != nil {
+		return m.Tournament
+	}
+	return ""
+}
+
+func (m *GameRef) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *GameRef) GetGame() uint32 {
+	if m != nil {
+		return m.Game
+	}
+	return 0
+}
+
+type CreateCompetitionRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCompetitionRequest) Reset()         { *m = CreateCompetitionRequest{} }
+func (m *CreateCompetitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCompetitionRequest) ProtoMessage()    {}
+func (*CreateCompetitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{2}
+}
+func (m *CreateCompetitionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateCompetitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateCompetitionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateCompetitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCompetitionRequest.Merge(m, src)
+}
+func (m *CreateCompetitionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateCompetitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCompetitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCompetitionRequest proto.InternalMessageInfo
+
+func (m *CreateCompetitionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CompetitionInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Tournaments          []string `protobuf:"bytes,2,rep,name=tournaments,proto3" json:"tournaments,omitempty"`
+	Arenas               []string `protobuf:"bytes,3,rep,name=arenas,proto3" json:"arenas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompetitionInfo) Reset()         { *m = CompetitionInfo{} }
+func (m *CompetitionInfo) String() string { return proto.CompactTextString(m) }
+func (*CompetitionInfo) ProtoMessage()    {}
+func (*CompetitionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{3}
+}
+func (m *CompetitionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompetitionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CompetitionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CompetitionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompetitionInfo.Merge(m, src)
+}
+func (m *CompetitionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompetitionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompetitionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompetitionInfo proto.InternalMessageInfo
+
+func (m *CompetitionInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CompetitionInfo) GetTournaments() []string {
+	if m != nil {
+		return m.Tournaments
+	}
+	return nil
+}
+
+func (m *CompetitionInfo) GetArenas() []string {
+	if m != nil {
+		return m.Arenas
+	}
+	return nil
+}
+
+type TeamEntry struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Players              []string `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TeamEntry) Reset()         { *m = TeamEntry{} }
+func (m *TeamEntry) String() string { return proto.CompactTextString(m) }
+func (*TeamEntry) ProtoMessage()    {}
+func (*TeamEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{4}
+}
+func (m *TeamEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeamEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeamEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TeamEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeamEntry.Merge(m, src)
+}
+func (m *TeamEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeamEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeamEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TeamEntry proto.InternalMessageInfo
+
+func (m *TeamEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TeamEntry) GetPlayers() []string {
+	if m != nil {
+		return m.Players
+	}
+	return nil
+}
+
+type AddTournamentRequest struct {
+	Competition          string         `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	Name                 string         `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 TournamentType `protobuf:"varint,3,opt,name=type,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.TournamentType" json:"type,omitempty"`
+	Seeded               bool           `protobuf:"varint,4,opt,name=seeded,proto3" json:"seeded,omitempty"`
+	GameSize             uint32         `protobuf:"varint,5,opt,name=game_size,json=gameSize,proto3" json:"game_size,omitempty"`
+	Advancing            uint32         `protobuf:"varint,6,opt,name=advancing,proto3" json:"advancing,omitempty"`
+	Scored               bool           `protobuf:"varint,7,opt,name=scored,proto3" json:"scored,omitempty"`
+	Teams                []*TeamEntry   `protobuf:"bytes,8,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddTournamentRequest) Reset()         { *m = AddTournamentRequest{} }
+func (m *AddTournamentRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTournamentRequest) ProtoMessage()    {}
+func (*AddTournamentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{5}
+}
+func (m *AddTournamentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddTournamentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddTournamentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddTournamentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTournamentRequest.Merge(m, src)
+}
+func (m *AddTournamentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddTournamentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTournamentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTournamentRequest proto.InternalMessageInfo
+
+func (m *AddTournamentRequest) GetCompetition() string {
+	if m != nil {
+		return m.Competition
+	}
+	return ""
+}
+
+func (m *AddTournamentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddTournamentRequest) GetType() TournamentType {
+	if m != nil {
+		return m.Type
+	}
+	return TournamentType_SINGLE_ELIMINATION
+}
+
+func (m *AddTournamentRequest) GetSeeded() bool {
+	if m != nil {
+		return m.Seeded
+	}
+	return false
+}
+
+func (m *AddTournamentRequest) GetGameSize() uint32 {
+	if m != nil {
+		return m.GameSize
+	}
+	return 0
+}
+
+func (m *AddTournamentRequest) GetAdvancing() uint32 {
+	if m != nil {
+		return m.Advancing
+	}
+	return 0
+}
+
+func (m *AddTournamentRequest) GetScored() bool {
+	if m != nil {
+		return m.Scored
+	}
+	return false
+}
+
+func (m *AddTournamentRequest) GetTeams() []*TeamEntry {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type TournamentInfo struct {
+	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 TournamentType `protobuf:"varint,2,opt,name=type,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.TournamentType" json:"type,omitempty"`
+	Status               Status         `protobuf:"varint,3,opt,name=status,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Status" json:"status,omitempty"`
+	GameSize             uint32         `protobuf:"varint,4,opt,name=game_size,json=gameSize,proto3" json:"game_size,omitempty"`
+	Advancing            uint32         `protobuf:"varint,5,opt,name=advancing,proto3" json:"advancing,omitempty"`
+	Seeded               bool           `protobuf:"varint,6,opt,name=seeded,proto3" json:"seeded,omitempty"`
+	Scored               bool           `protobuf:"varint,7,opt,name=scored,proto3" json:"scored,omitempty"`
+	Teams                []string       `protobuf:"bytes,8,rep,name=teams,proto3" json:"teams,omitempty"`
+	Brackets             []string       `protobuf:"bytes,9,rep,name=brackets,proto3" json:"brackets,omitempty"`
+	Rounds               uint32         `protobuf:"varint,10,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TournamentInfo) Reset()         { *m = TournamentInfo{} }
+func (m *TournamentInfo) String() string { return proto.CompactTextString(m) }
+func (*TournamentInfo) ProtoMessage()    {}
+func (*TournamentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{6}
+}
+func (m *TournamentInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TournamentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TournamentInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TournamentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TournamentInfo.Merge(m, src)
+}
+func (m *TournamentInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *TournamentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_TournamentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TournamentInfo proto.InternalMessageInfo
+
+func (m *TournamentInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TournamentInfo) GetType() TournamentType {
+	if m != nil {
+		return m.Type
+	}
+	return TournamentType_SINGLE_ELIMINATION
+}
+
+func (m *TournamentInfo) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_NEW
+}
+
+func (m *TournamentInfo) GetGameSize() uint32 {
+	if m != nil {
+		return m.GameSize
+	}
+	return 0
+}
+
+func (m *TournamentInfo) GetAdvancing() uint32 {
+	if m != nil {
+		return m.Advancing
+	}
+	return 0
+}
+
+func (m *TournamentInfo) GetSeeded() bool {
+	if m != nil {
+		return m.Seeded
+	}
+	return false
+}
+
+func (m *TournamentInfo) GetScored() bool {
+	if m != nil {
+		return m.Scored
+	}
+	return false
+}
+
+func (m *TournamentInfo) GetTeams() []string {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+func (m *TournamentInfo) GetBrackets() []string {
+	if m != nil {
+		return m.Brackets
+	}
+	return nil
+}
+
+func (m *TournamentInfo) GetRounds() uint32 {
+	if m != nil {
+		return m.Rounds
+	}
+	return 0
+}
+
+type RoundInfo struct {
+	Number               uint32      `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Status               Status      `protobuf:"varint,2,opt,name=status,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Status" json:"status,omitempty"`
+	Games                []*GameInfo `protobuf:"bytes,3,rep,name=games,proto3" json:"games,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RoundInfo) Reset()         { *m = RoundInfo{} }
+func (m *RoundInfo) String() string { return proto.CompactTextString(m) }
+func (*RoundInfo) ProtoMessage()    {}
+func (*RoundInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{7}
+}
+func (m *RoundInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoundInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundInfo.Merge(m, src)
+}
+func (m *RoundInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundInfo proto.InternalMessageInfo
+
+func (m *RoundInfo) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *RoundInfo) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_NEW
+}
+
+func (m *RoundInfo) GetGames() []*GameInfo {
+	if m != nil {
+		return m.Games
+	}
+	return nil
+}
+
+type GameInfo struct {
+	Round                uint32   `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Number               uint32   `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Bracket              string   `protobuf:"bytes,3,opt,name=bracket,proto3" json:"bracket,omitempty"`
+	Status               Status   `protobuf:"varint,4,opt,name=status,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Status" json:"status,omitempty"`
+	Teams                []string `protobuf:"bytes,5,rep,name=teams,proto3" json:"teams,omitempty"`
+	Scores               []int64  `protobuf:"varint,6,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Places               []int64  `protobuf:"varint,7,rep,packed,name=places,proto3" json:"places,omitempty"`
+	Results              []Result `protobuf:"varint,8,rep,packed,name=results,proto3,enum=dev.justinjudd.org.justin.competition.models.storm.pb.Result" json:"results,omitempty"`
+	Arena                string   `protobuf:"bytes,9,opt,name=arena,proto3" json:"arena,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameInfo) Reset()         { *m = GameInfo{} }
+func (m *GameInfo) String() string { return proto.CompactTextString(m) }
+func (*GameInfo) ProtoMessage()    {}
+func (*GameInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{8}
+}
+func (m *GameInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GameInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GameInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GameInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameInfo.Merge(m, src)
+}
+func (m *GameInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GameInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameInfo proto.InternalMessageInfo
+
+func (m *GameInfo) GetRound() uint32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *GameInfo) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *GameInfo) GetBracket() string {
+	if m != nil {
+		return m.Bracket
+	}
+	return ""
+}
+
+func (m *GameInfo) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_NEW
+}
+
+func (m *GameInfo) GetTeams() []string {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+func (m *GameInfo) GetScores() []int64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *GameInfo) GetPlaces() []int64 {
+	if m != nil {
+		return m.Places
+	}
+	return nil
+}
+
+func (m *GameInfo) GetResults() []Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *GameInfo) GetArena() string {
+	if m != nil {
+		return m.Arena
+	}
+	return ""
+}
+
+type SubmitResultRequest struct {
+	Game                 *GameRef `protobuf:"bytes,1,opt,name=game,proto3" json:"game,omitempty"`
+	Scores               []int64  `protobuf:"varint,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Places               []int64  `protobuf:"varint,3,rep,packed,name=places,proto3" json:"places,omitempty"`
+	Final                bool     `protobuf:"varint,4,opt,name=final,proto3" json:"final,omitempty"`
+	Official             string   `protobuf:"bytes,5,opt,name=official,proto3" json:"official,omitempty"`
+	Replay               bool     `protobuf:"varint,6,opt,name=replay,proto3" json:"replay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitResultRequest) Reset()         { *m = SubmitResultRequest{} }
+func (m *SubmitResultRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitResultRequest) ProtoMessage()    {}
+func (*SubmitResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{9}
+}
+func (m *SubmitResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmitResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmitResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmitResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitResultRequest.Merge(m, src)
+}
+func (m *SubmitResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmitResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitResultRequest proto.InternalMessageInfo
+
+func (m *SubmitResultRequest) GetGame() *GameRef {
+	if m != nil {
+		return m.Game
+	}
+	return nil
+}
+
+func (m *SubmitResultRequest) GetScores() []int64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *SubmitResultRequest) GetPlaces() []int64 {
+	if m != nil {
+		return m.Places
+	}
+	return nil
+}
+
+func (m *SubmitResultRequest) GetFinal() bool {
+	if m != nil {
+		return m.Final
+	}
+	return false
+}
+
+func (m *SubmitResultRequest) GetOfficial() string {
+	if m != nil {
+		return m.Official
+	}
+	return ""
+}
+
+func (m *SubmitResultRequest) GetReplay() bool {
+	if m != nil {
+		return m.Replay
+	}
+	return false
+}
+
+type Bracket struct {
+	Tournament           *TournamentInfo `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	Rounds               []*RoundInfo    `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Bracket) Reset()         { *m = Bracket{} }
+func (m *Bracket) String() string { return proto.CompactTextString(m) }
+func (*Bracket) ProtoMessage()    {}
+func (*Bracket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{10}
+}
+func (m *Bracket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bracket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bracket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Bracket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bracket.Merge(m, src)
+}
+func (m *Bracket) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bracket) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bracket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bracket proto.InternalMessageInfo
+
+func (m *Bracket) GetTournament() *TournamentInfo {
+	if m != nil {
+		return m.Tournament
+	}
+	return nil
+}
+
+func (m *Bracket) GetRounds() []*RoundInfo {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TournamentRef)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.TournamentRef")
+	proto.RegisterType((*GameRef)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.GameRef")
+	proto.RegisterType((*CreateCompetitionRequest)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.CreateCompetitionRequest")
+	proto.RegisterType((*CompetitionInfo)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionInfo")
+	proto.RegisterType((*TeamEntry)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.TeamEntry")
+	proto.RegisterType((*AddTournamentRequest)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.AddTournamentRequest")
+	proto.RegisterType((*TournamentInfo)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.TournamentInfo")
+	proto.RegisterType((*RoundInfo)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.RoundInfo")
+	proto.RegisterType((*GameInfo)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.GameInfo")
+	proto.RegisterType((*SubmitResultRequest)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.SubmitResultRequest")
+	proto.RegisterType((*Bracket)(nil), "dev.justinjudd.org.justin.competition.models.storm.pb.Bracket")
+}
+
+func init() { proto.RegisterFile("service.proto", fileDescriptor_a0b84a42fa06f626) }
+
+var fileDescriptor_a0b84a42fa06f626 = []byte{
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x8e, 0xe3, 0x44,
+	0x10, 0xa6, 0x9d, 0x5f, 0xd7, 0x6c, 0x16, 0xd1, 0x44, 0xab, 0x56, 0x40, 0x51, 0xe4, 0xd3, 0x9c,
+	0x2c, 0x14, 0xc4, 0x81, 0x03, 0x7f, 0xbb, 0x2c, 0x2b, 0x40, 0x02, 0xd1, 0x33, 0xcb, 0x02, 0x97,
+	0x55, 0xc7, 0xae, 0x0c, 0x5e, 0xe2, 0x1f, 0xdc, 0xed, 0x11, 0xb3, 0xaf, 0x80, 0xc4, 0x01, 0x2e,
+	0x48, 0x1c, 0xb9, 0xf2, 0x16, 0x5c, 0x10, 0x27, 0x78, 0x01, 0x84, 0x66, 0x6f, 0x3c, 0x05, 0xea,
+	0x76, 0x3b, 0xe9, 0xb0, 0x33, 0xac, 0x64, 0xe7, 0xe6, 0xaf, 0xdc, 0xf9, 0xba, 0xeb, 0xfb, 0xba,
+	0xaa, 0x1c, 0x98, 0x48, 0x2c, 0xcf, 0x93, 0x08, 0xc3, 0xa2, 0xcc, 0x55, 0x4e, 0x5f, 0x8b, 0xf1,
+	0x3c, 0x7c, 0x54, 0x49, 0x95, 0x64, 0x8f, 0xaa, 0x38, 0x0e, 0xf3, 0xf2, 0xcc, 0xc2, 0x30, 0xca,
+	0xd3, 0x02, 0x55, 0xa2, 0x92, 0x3c, 0x0b, 0xd3, 0x3c, 0xc6, 0x8d, 0x0c, 0xa5, 0xca, 0xcb, 0x34,
+	0x2c, 0x56, 0xb3, 0x1b, 0x36, 0x60, 0x48, 0x82, 0x4f, 0x60, 0x72, 0x9a, 0x57, 0x65, 0x26, 0x52,
+	0xcc, 0x14, 0xc7, 0x35, 0x5d, 0xc0, 0x91, 0xf3, 0x6b, 0x46, 0x16, 0xe4, 0xd8, 0xe7, 0x6e, 0x88,
+	0xce, 0x01, 0xd4, 0xf6, 0x27, 0xcc, 0x33, 0x0b, 0x9c, 0x48, 0x50, 0xc1, 0xe8, 0x9e, 0x48, 0xf1,
+	0x20, 0x64, 0x74, 0x0a, 0x83, 0x32, 0xaf, 0xb2, 0x98, 0xf5, 0x16, 0xe4, 0x78, 0xc2, 0x6b, 0x40,
+	0x29, 0xf4, 0xcf, 0x44, 0x8a, 0xac, 0x6f, 0x82, 0xe6, 0x39, 0x08, 0x81, 0xdd, 0x29, 0x51, 0x28,
+	0xbc, 0xb3, 0xa3, 0xe7, 0xf8, 0x75, 0x85, 0x52, 0xe9, 0xf5, 0x9a, 0xcf, 0x1e, 0xc0, 0x3c, 0x07,
+	0x0f, 0xe1, 0x79, 0x67, 0xe5, 0xfb, 0xd9, 0x3a, 0xbf, 0x6a, 0x99, 0x4e, 0x61, 0x77, 0x1c, 0xc9,
+	0xbc, 0x45, 0x4f, 0xa7, 0xe0, 0x84, 0xe8, 0x2d, 0x18, 0x8a, 0x12, 0x33, 0x21, 0x59, 0xcf, 0xbc,
+	0xb4, 0x28, 0x78, 0x1d, 0xfc, 0x53, 0x14, 0xe9, 0xdd, 0x4c, 0x95, 0x17, 0x57, 0x52, 0x33, 0x18,
+	0x15, 0x1b, 0x71, 0x81, 0x65, 0x43, 0xdb, 0xc0, 0xe0, 0x1f, 0x0f, 0xa6, 0xef, 0xc4, 0xb1, 0xeb,
+	0x4c, 0x9d, 0xc8, 0xb3, 0x05, 0x6d, 0x36, 0xf2, 0x9c, 0x8d, 0x3e, 0x87, 0xbe, 0xba, 0x28, 0xd0,
+	0x68, 0x78, 0x73, 0x79, 0x37, 0x6c, 0x75, 0x71, 0xc2, 0xdd, 0x69, 0x4e, 0x2f, 0x0a, 0xe4, 0x86,
+	0x52, 0x27, 0x2f, 0x11, 0x63, 0x8c, 0x8d, 0x17, 0x63, 0x6e, 0x11, 0x7d, 0x09, 0x7c, 0xed, 0xca,
+	0x43, 0x99, 0x3c, 0x46, 0x36, 0x30, 0x36, 0x8d, 0x75, 0xe0, 0x24, 0x79, 0x8c, 0xf4, 0x65, 0xf0,
+	0x45, 0x7c, 0x2e, 0xb2, 0x28, 0xc9, 0xce, 0xd8, 0xd0, 0xbc, 0xdc, 0x05, 0x0c, 0x65, 0x94, 0x97,
+	0x18, 0xb3, 0x91, 0xa5, 0x34, 0x88, 0x7e, 0x0a, 0x03, 0x85, 0x22, 0x95, 0x6c, 0xbc, 0xe8, 0x1d,
+	0x1f, 0x2d, 0xdf, 0x6e, 0x9b, 0x46, 0xe3, 0x09, 0xaf, 0xe9, 0x82, 0x6f, 0x7b, 0x70, 0x73, 0x97,
+	0xdb, 0xb5, 0x17, 0xa1, 0x11, 0xd1, 0x3b, 0xbc, 0x88, 0xf7, 0x61, 0x28, 0x95, 0x50, 0x95, 0xb4,
+	0x0e, 0xbd, 0xd1, 0x92, 0xfc, 0xc4, 0x90, 0x70, 0x4b, 0xb6, 0xef, 0x41, 0xff, 0xff, 0x3c, 0x18,
+	0x5c, 0xe5, 0x41, 0x6d, 0xeb, 0x70, 0xcf, 0xd6, 0xeb, 0xbc, 0x99, 0xba, 0xde, 0xf8, 0x56, 0x59,
+	0x3a, 0x83, 0xf1, 0xaa, 0x14, 0xd1, 0x57, 0xa8, 0x24, 0xf3, 0xcd, 0x8b, 0x2d, 0xd6, 0x4c, 0xa6,
+	0x96, 0x25, 0x03, 0xb3, 0xb9, 0x45, 0xc1, 0x9f, 0x04, 0x7c, 0xae, 0x1f, 0x8d, 0x11, 0xb7, 0x60,
+	0x98, 0x55, 0xe9, 0x0a, 0x4b, 0x63, 0xc5, 0x84, 0x5b, 0xe4, 0x28, 0xe6, 0x1d, 0x52, 0xb1, 0xfb,
+	0x30, 0xd0, 0x02, 0xd5, 0x95, 0x7c, 0xb4, 0x7c, 0xab, 0x25, 0xab, 0x6e, 0x7f, 0xfa, 0xf8, 0xbc,
+	0x66, 0x0b, 0xfe, 0xf2, 0x60, 0xdc, 0xc4, 0x76, 0x1d, 0x8d, 0xb8, 0x1d, 0x6d, 0x97, 0xa8, 0xb7,
+	0x97, 0x28, 0x83, 0x91, 0x95, 0xcc, 0xdc, 0x0d, 0x9f, 0x37, 0xd0, 0x91, 0xa0, 0x7f, 0x48, 0x09,
+	0xb6, 0x4e, 0x0e, 0x5c, 0x27, 0x1b, 0xdf, 0x25, 0x1b, 0x2e, 0x7a, 0xc7, 0x3d, 0xeb, 0xbb, 0x89,
+	0x17, 0x1b, 0x11, 0xa1, 0x64, 0xa3, 0x3a, 0x5e, 0x23, 0xfa, 0x00, 0x46, 0x25, 0xca, 0x6a, 0xa3,
+	0xea, 0x1b, 0xd1, 0xfe, 0x74, 0xdc, 0xb0, 0xf0, 0x86, 0x4d, 0x1f, 0xcf, 0xb4, 0x57, 0xe6, 0x1b,
+	0x35, 0x6a, 0x10, 0x3c, 0x21, 0xf0, 0xe2, 0x49, 0xb5, 0x4a, 0x13, 0x65, 0xd7, 0xdb, 0x76, 0xc9,
+	0xed, 0x9c, 0xd0, 0x52, 0x1f, 0x2d, 0xdf, 0xec, 0x60, 0x27, 0xc7, 0x75, 0x3d, 0x67, 0x1c, 0x29,
+	0xbc, 0x6b, 0xa4, 0xe8, 0xed, 0x49, 0x31, 0x85, 0xc1, 0x3a, 0xc9, 0xc4, 0xc6, 0x36, 0xc8, 0x1a,
+	0xe8, 0xd2, 0xc8, 0xd7, 0xeb, 0x24, 0x4a, 0xc4, 0xc6, 0x54, 0x9f, 0xcf, 0xb7, 0xd8, 0x94, 0x06,
+	0xea, 0x51, 0xd0, 0x14, 0x5f, 0x8d, 0x82, 0xdf, 0x09, 0x8c, 0x6e, 0x5b, 0xf7, 0x71, 0x6f, 0x6e,
+	0xd6, 0xf9, 0x75, 0xef, 0x49, 0xe6, 0xd2, 0xba, 0xe3, 0xf7, 0xb3, 0x6d, 0x95, 0x7a, 0x9d, 0x9a,
+	0xee, 0xb6, 0xa2, 0x9b, 0x3a, 0x5f, 0xfe, 0x3a, 0x02, 0xea, 0xcc, 0xdf, 0x93, 0xfa, 0xd3, 0x86,
+	0xfe, 0x42, 0xe0, 0x85, 0xa7, 0xc6, 0x38, 0xfd, 0xb8, 0xe5, 0xb6, 0xd7, 0x7d, 0x10, 0xcc, 0xde,
+	0x6b, 0x4b, 0xf8, 0x9f, 0x2f, 0x86, 0x9f, 0x09, 0x4c, 0xf6, 0x06, 0x35, 0xfd, 0xb0, 0x25, 0xf3,
+	0x55, 0xe3, 0x7e, 0x76, 0x18, 0x47, 0xe9, 0x0f, 0x04, 0xfc, 0x8f, 0xf0, 0x1b, 0x65, 0x5c, 0xa0,
+	0xef, 0x76, 0x26, 0xe5, 0xb8, 0x9e, 0x75, 0xbe, 0x09, 0xf4, 0x27, 0x02, 0x37, 0xdc, 0xa2, 0xa5,
+	0x1f, 0xb4, 0xed, 0x60, 0x4f, 0x57, 0xfe, 0xac, 0x6b, 0xeb, 0xa6, 0xdf, 0x13, 0x80, 0x7b, 0xa8,
+	0x9a, 0x7a, 0x3b, 0x8c, 0x68, 0x6d, 0x3b, 0x50, 0x73, 0x8a, 0xef, 0x08, 0xf8, 0x0f, 0x84, 0x8a,
+	0xbe, 0xd4, 0xc7, 0xa4, 0x1d, 0xfb, 0x59, 0x67, 0x8d, 0x5e, 0x21, 0xb7, 0xa7, 0xbf, 0x5d, 0xce,
+	0xc9, 0x1f, 0x97, 0x73, 0xf2, 0xf7, 0xe5, 0x9c, 0xfc, 0xf8, 0x64, 0xfe, 0xdc, 0x17, 0x5e, 0xb1,
+	0x5a, 0x0d, 0xcd, 0x7f, 0x8b, 0x57, 0xff, 0x1d, 0x00, 0x67, 0x47, 0x2a, 0x43, 0xb1, 0x0c, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CompetitionServiceClient is the client API for CompetitionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CompetitionServiceClient interface {
+	CreateCompetition(ctx context.Context, in *CreateCompetitionRequest, opts ...grpc.CallOption) (*CompetitionInfo, error)
+	AddTournament(ctx context.Context, in *AddTournamentRequest, opts ...grpc.CallOption) (*TournamentInfo, error)
+	NextRound(ctx context.Context, in *TournamentRef, opts ...grpc.CallOption) (*RoundInfo, error)
+	SubmitResult(ctx context.Context, in *SubmitResultRequest, opts ...grpc.CallOption) (*GameInfo, error)
+	GetBracket(ctx context.Context, in *TournamentRef, opts ...grpc.CallOption) (*Bracket, error)
+	// WatchGame sends the game as it is now, then again every time its scores, places, status, arena or bracket change
+	WatchGame(ctx context.Context, in *GameRef, opts ...grpc.CallOption) (CompetitionService_WatchGameClient, error)
+}
+
+type competitionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCompetitionServiceClient(cc *grpc.ClientConn) CompetitionServiceClient {
+	return &competitionServiceClient{cc}
+}
+
+func (c *competitionServiceClient) CreateCompetition(ctx context.Context, in *CreateCompetitionRequest, opts ...grpc.CallOption) (*CompetitionInfo, error) {
+	out := new(CompetitionInfo)
+	err := c.cc.Invoke(ctx, "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/CreateCompetition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *competitionServiceClient) AddTournament(ctx context.Context, in *AddTournamentRequest, opts ...grpc.CallOption) (*TournamentInfo, error) {
+	out := new(TournamentInfo)
+	err := c.cc.Invoke(ctx, "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/AddTournament", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *competitionServiceClient) NextRound(ctx context.Context, in *TournamentRef, opts ...grpc.CallOption) (*RoundInfo, error) {
+	out := new(RoundInfo)
+	err := c.cc.Invoke(ctx, "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/NextRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *competitionServiceClient) SubmitResult(ctx context.Context, in *SubmitResultRequest, opts ...grpc.CallOption) (*GameInfo, error) {
+	out := new(GameInfo)
+	err := c.cc.Invoke(ctx, "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/SubmitResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *competitionServiceClient) GetBracket(ctx context.Context, in *TournamentRef, opts ...grpc.CallOption) (*Bracket, error) {
+	out := new(Bracket)
+	err := c.cc.Invoke(ctx, "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/GetBracket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *competitionServiceClient) WatchGame(ctx context.Context, in *GameRef, opts ...grpc.CallOption) (CompetitionService_WatchGameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CompetitionService_serviceDesc.Streams[0], "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/WatchGame", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &competitionServiceWatchGameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CompetitionService_WatchGameClient interface {
+	Recv() (*GameInfo, error)
+	grpc.ClientStream
+}
+
+type competitionServiceWatchGameClient struct {
+	grpc.ClientStream
+}
+
+func (x *competitionServiceWatchGameClient) Recv() (*GameInfo, error) {
+	m := new(GameInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CompetitionServiceServer is the server API for CompetitionService service.
+type CompetitionServiceServer interface {
+	CreateCompetition(context.Context, *CreateCompetitionRequest) (*CompetitionInfo, error)
+	AddTournament(context.Context, *AddTournamentRequest) (*TournamentInfo, error)
+	NextRound(context.Context, *TournamentRef) (*RoundInfo, error)
+	SubmitResult(context.Context, *SubmitResultRequest) (*GameInfo, error)
+	GetBracket(context.Context, *TournamentRef) (*Bracket, error)
+	// WatchGame sends the game as it is now, then again every time its scores, places, status, arena or bracket change
+	WatchGame(*GameRef, CompetitionService_WatchGameServer) error
+}
+
+// UnimplementedCompetitionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCompetitionServiceServer struct {
+}
+
+func (*UnimplementedCompetitionServiceServer) CreateCompetition(ctx context.Context, req *CreateCompetitionRequest) (*CompetitionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompetition not implemented")
+}
+func (*UnimplementedCompetitionServiceServer) AddTournament(ctx context.Context, req *AddTournamentRequest) (*TournamentInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTournament not implemented")
+}
+func (*UnimplementedCompetitionServiceServer) NextRound(ctx context.Context, req *TournamentRef) (*RoundInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextRound not implemented")
+}
+func (*UnimplementedCompetitionServiceServer) SubmitResult(ctx context.Context, req *SubmitResultRequest) (*GameInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitResult not implemented")
+}
+func (*UnimplementedCompetitionServiceServer) GetBracket(ctx context.Context, req *TournamentRef) (*Bracket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBracket not implemented")
+}
+func (*UnimplementedCompetitionServiceServer) WatchGame(req *GameRef, srv CompetitionService_WatchGameServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGame not implemented")
+}
+
+func RegisterCompetitionServiceServer(s *grpc.Server, srv CompetitionServiceServer) {
+	s.RegisterService(&_CompetitionService_serviceDesc, srv)
+}
+
+func _CompetitionService_CreateCompetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompetitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompetitionServiceServer).CreateCompetition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/CreateCompetition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompetitionServiceServer).CreateCompetition(ctx, req.(*CreateCompetitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompetitionService_AddTournament_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTournamentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompetitionServiceServer).AddTournament(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/AddTournament",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompetitionServiceServer).AddTournament(ctx, req.(*AddTournamentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompetitionService_NextRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompetitionServiceServer).NextRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/NextRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompetitionServiceServer).NextRound(ctx, req.(*TournamentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompetitionService_SubmitResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompetitionServiceServer).SubmitResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/SubmitResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompetitionServiceServer).SubmitResult(ctx, req.(*SubmitResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompetitionService_GetBracket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TournamentRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompetitionServiceServer).GetBracket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService/GetBracket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompetitionServiceServer).GetBracket(ctx, req.(*TournamentRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompetitionService_WatchGame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GameRef)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CompetitionServiceServer).WatchGame(m, &competitionServiceWatchGameServer{stream})
+}
+
+type CompetitionService_WatchGameServer interface {
+	Send(*GameInfo) error
+	grpc.ServerStream
+}
+
+type competitionServiceWatchGameServer struct {
+	grpc.ServerStream
+}
+
+func (x *competitionServiceWatchGameServer) Send(m *GameInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _CompetitionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dev.justinjudd.org.justin.competition.models.storm.pb.CompetitionService",
+	HandlerType: (*CompetitionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCompetition",
+			Handler:    _CompetitionService_CreateCompetition_Handler,
+		},
+		{
+			MethodName: "AddTournament",
+			Handler:    _CompetitionService_AddTournament_Handler,
+		},
+		{
+			MethodName: "NextRound",
+			Handler:    _CompetitionService_NextRound_Handler,
+		},
+		{
+			MethodName: "SubmitResult",
+			Handler:    _CompetitionService_SubmitResult_Handler,
+		},
+		{
+			MethodName: "GetBracket",
+			Handler:    _CompetitionService_GetBracket_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGame",
+			Handler:       _CompetitionService_WatchGame_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+func (m *TournamentRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tournament) > 0 {
+		i -= len(m.Tournament)
+		copy(dAtA[i:], m.Tournament)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Tournament)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Competition) > 0 {
+		i -= len(m.Competition)
+		copy(dAtA[i:], m.Competition)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Competition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Game != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Game))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tournament) > 0 {
+		i -= len(m.Tournament)
+		copy(dAtA[i:], m.Tournament)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Tournament)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Competition) > 0 {
+		i -= len(m.Competition)
+		copy(dAtA[i:], m.Competition)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Competition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateCompetitionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateCompetitionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateCompetitionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CompetitionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompetitionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompetitionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Arenas) > 0 {
+		for iNdEx := len(m.Arenas) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arenas[iNdEx])
+			copy(dAtA[i:], m.Arenas[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Arenas[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tournaments) > 0 {
+		for iNdEx := len(m.Tournaments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tournaments[iNdEx])
+			copy(dAtA[i:], m.Tournaments[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Tournaments[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeamEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeamEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeamEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Players) > 0 {
+		for iNdEx := len(m.Players) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Players[iNdEx])
+			copy(dAtA[i:], m.Players[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Players[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddTournamentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddTournamentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddTournamentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Teams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Scored {
+		i--
+		if m.Scored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Advancing != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Advancing))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GameSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GameSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Seeded {
+		i--
+		if m.Seeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Competition) > 0 {
+		i -= len(m.Competition)
+		copy(dAtA[i:], m.Competition)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Competition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TournamentInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TournamentInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TournamentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rounds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Rounds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Brackets) > 0 {
+		for iNdEx := len(m.Brackets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brackets[iNdEx])
+			copy(dAtA[i:], m.Brackets[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Brackets[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Teams[iNdEx])
+			copy(dAtA[i:], m.Teams[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Teams[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Scored {
+		i--
+		if m.Scored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Seeded {
+		i--
+		if m.Seeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Advancing != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Advancing))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GameSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GameSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Games) > 0 {
+		for iNdEx := len(m.Games) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Games[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GameInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GameInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GameInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Arena) > 0 {
+		i -= len(m.Arena)
+		copy(dAtA[i:], m.Arena)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Arena)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Results) > 0 {
+		dAtA2 := make([]byte, len(m.Results)*10)
+		var j1 int
+		for _, num := range m.Results {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintService(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Places) > 0 {
+		dAtA4 := make([]byte, len(m.Places)*10)
+		var j3 int
+		for _, num1 := range m.Places {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintService(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Scores) > 0 {
+		dAtA6 := make([]byte, len(m.Scores)*10)
+		var j5 int
+		for _, num1 := range m.Scores {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintService(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Teams) > 0 {
+		for iNdEx := len(m.Teams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Teams[iNdEx])
+			copy(dAtA[i:], m.Teams[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Teams[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Bracket) > 0 {
+		i -= len(m.Bracket)
+		copy(dAtA[i:], m.Bracket)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Bracket)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmitResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmitResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Replay {
+		i--
+		if m.Replay {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Official) > 0 {
+		i -= len(m.Official)
+		copy(dAtA[i:], m.Official)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Official)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Final {
+		i--
+		if m.Final {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Places) > 0 {
+		dAtA8 := make([]byte, len(m.Places)*10)
+		var j7 int
+		for _, num1 := range m.Places {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintService(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Scores) > 0 {
+		dAtA10 := make([]byte, len(m.Scores)*10)
+		var j9 int
+		for _, num1 := range m.Scores {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintService(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Game != nil {
+		{
+			size, err := m.Game.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bracket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bracket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bracket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Tournament != nil {
+		{
+			size, err := m.Tournament.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TournamentRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Competition)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Tournament)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GameRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Competition)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Tournament)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovService(uint64(m.Round))
+	}
+	if m.Game != 0 {
+		n += 1 + sovService(uint64(m.Game))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateCompetitionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompetitionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Tournaments) > 0 {
+		for _, s := range m.Tournaments {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Arenas) > 0 {
+		for _, s := range m.Arenas {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeamEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Players) > 0 {
+		for _, s := range m.Players {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddTournamentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Competition)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.Seeded {
+		n += 2
+	}
+	if m.GameSize != 0 {
+		n += 1 + sovService(uint64(m.GameSize))
+	}
+	if m.Advancing != 0 {
+		n += 1 + sovService(uint64(m.Advancing))
+	}
+	if m.Scored {
+		n += 2
+	}
+	if len(m.Teams) > 0 {
+		for _, e := range m.Teams {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TournamentInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.Status != 0 {
+		n += 1 + sovService(uint64(m.Status))
+	}
+	if m.GameSize != 0 {
+		n += 1 + sovService(uint64(m.GameSize))
+	}
+	if m.Advancing != 0 {
+		n += 1 + sovService(uint64(m.Advancing))
+	}
+	if m.Seeded {
+		n += 2
+	}
+	if m.Scored {
+		n += 2
+	}
+	if len(m.Teams) > 0 {
+		for _, s := range m.Teams {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Brackets) > 0 {
+		for _, s := range m.Brackets {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Rounds != 0 {
+		n += 1 + sovService(uint64(m.Rounds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RoundInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovService(uint64(m.Number))
+	}
+	if m.Status != 0 {
+		n += 1 + sovService(uint64(m.Status))
+	}
+	if len(m.Games) > 0 {
+		for _, e := range m.Games {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GameInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovService(uint64(m.Round))
+	}
+	if m.Number != 0 {
+		n += 1 + sovService(uint64(m.Number))
+	}
+	l = len(m.Bracket)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovService(uint64(m.Status))
+	}
+	if len(m.Teams) > 0 {
+		for _, s := range m.Teams {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Scores) > 0 {
+		l = 0
+		for _, e := range m.Scores {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if len(m.Places) > 0 {
+		l = 0
+		for _, e := range m.Places {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if len(m.Results) > 0 {
+		l = 0
+		for _, e := range m.Results {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	l = len(m.Arena)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubmitResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Game != nil {
+		l = m.Game.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Scores) > 0 {
+		l = 0
+		for _, e := range m.Scores {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if len(m.Places) > 0 {
+		l = 0
+		for _, e := range m.Places {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.Final {
+		n += 2
+	}
+	l = len(m.Official)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Replay {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bracket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tournament != nil {
+		l = m.Tournament.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TournamentRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Competition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Competition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tournament", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tournament = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Competition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Competition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tournament", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tournament = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
+			}
+			m.Game = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Game |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateCompetitionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateCompetitionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateCompetitionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompetitionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompetitionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompetitionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tournaments", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tournaments = append(m.Tournaments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arenas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arenas = append(m.Arenas, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeamEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeamEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeamEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Players", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Players = append(m.Players, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddTournamentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddTournamentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddTournamentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Competition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Competition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TournamentType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Seeded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameSize", wireType)
+			}
+			m.GameSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advancing", wireType)
+			}
+			m.Advancing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Advancing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scored = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, &TeamEntry{})
+			if err := m.Teams[len(m.Teams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TournamentInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TournamentInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TournamentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TournamentType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameSize", wireType)
+			}
+			m.GameSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GameSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advancing", wireType)
+			}
+			m.Advancing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Advancing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Seeded = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scored = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brackets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brackets = append(m.Brackets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			m.Rounds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rounds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Games", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Games = append(m.Games, &GameInfo{})
+			if err := m.Games[len(m.Games)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GameInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GameInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GameInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bracket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bracket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Teams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Teams = append(m.Teams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Scores = append(m.Scores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Scores) == 0 {
+					m.Scores = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Scores = append(m.Scores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Places = append(m.Places, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Places) == 0 {
+					m.Places = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Places = append(m.Places, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Places", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v Result
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Result(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Results) == 0 {
+					m.Results = make([]Result, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Result
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Result(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arena", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arena = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Game", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Game == nil {
+				m.Game = &GameRef{}
+			}
+			if err := m.Game.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Scores = append(m.Scores, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Scores) == 0 {
+					m.Scores = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Scores = append(m.Scores, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Places = append(m.Places, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Places) == 0 {
+					m.Places = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Places = append(m.Places, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Places", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Final", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Final = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Official", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Official = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replay", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replay = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bracket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bracket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bracket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tournament", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tournament == nil {
+				m.Tournament = &TournamentInfo{}
+			}
+			if err := m.Tournament.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rounds = append(m.Rounds, &RoundInfo{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipService(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthService
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupService
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthService
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthService        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowService          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupService = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,192 @@
+package storm
+
+import (
+	"sort"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+
+	"github.com/asdine/storm/q"
+)
+
+// sortQueue puts the games at an arena in the order they'll be played: games being played first, then by scheduled start,
+// with games that haven't been scheduled after those that have, then in the order they were created
+func sortQueue(games []pb.Game) {
+	sort.SliceStable(games, func(i, j int) bool {
+		a, b := games[i], games[j]
+		if (a.Status == pb.Status_ONGOING) != (b.Status == pb.Status_ONGOING) {
+			return a.Status == pb.Status_ONGOING
+		}
+		if (a.StartTime == 0) != (b.StartTime == 0) {
+			return a.StartTime != 0
+		}
+		if a.StartTime != b.StartTime {
+			return a.StartTime < b.StartTime
+		}
+		return a.Id < b.Id
+	})
+}
+
+func (a *arena) queue() []pb.Game {
+	var games []pb.Game
+	a.Select(q.Eq("ArenaId", a.Id), q.In("Status", []pb.Status{pb.Status_NEW, pb.Status_ONGOING}), q.Eq("ParentId", uint64(0))).Find(&games)
+	sortQueue(games)
+	return games
+}
+
+func (a *arena) NextGame() models.Game {
+	for _, g := range a.queue() {
+		if g.Status == pb.Status_ONGOING || a.ready(g) {
+			return &game{g, a.store}
+		}
+	}
+	return nil
+}
+
+// playing reports whether an arena has a game being played in it
+func (a *arena) playing() bool {
+	n, _ := a.Select(q.Eq("ArenaId", a.Id), q.Eq("Status", pb.Status_ONGOING), q.Eq("ParentId", uint64(0))).Count(new(pb.Game))
+	return n > 0
+}
+
+// ready reports whether a game waiting to be played can start now: it has at least two teams, and none of them are playing another game
+func (s *store) ready(g pb.Game) bool {
+	if g.Status != pb.Status_NEW {
+		return false
+	}
+	for _, t := range (&game{g, s}).GetTeams() {
+		if models.IsByeTeam(t) {
+			continue
+		}
+		var others []pb.GameTeam
+		s.Select(q.Eq("TeamId", t.(*team).Id)).Find(&others)
+		for _, other := range others {
+			if other.GameId == g.Id {
+				continue
+			}
+			n, _ := s.Select(q.Eq("Id", other.GameId), q.Eq("Status", pb.Status_ONGOING), q.Eq("ParentId", uint64(0))).Count(new(pb.Game))
+			if n > 0 {
+				return false
+			}
+		}
+	}
+	return s.playingTeams(g) >= 2
+}
+
+// playingTeams counts the teams of a game, leaving out byes
+func (s *store) playingTeams(g pb.Game) int {
+	n := 0
+	for _, t := range (&game{g, s}).GetTeams() {
+		if !models.IsByeTeam(t) {
+			n++
+		}
+	}
+	return n
+}
+
+// competitionArenas returns the arenas of a competition, in the order they were created
+func (s *store) competitionArenas(competitionId uint64) []pb.Arena {
+	var arenas []pb.Arena
+	s.Select(q.Eq("CompetitionId", competitionId)).OrderBy("Id").Find(&arenas)
+	return arenas
+}
+
+// leastLoaded returns the arena of a competition with the fewest games waiting at it, the first one created when several are tied
+func (s *store) leastLoaded(competitionId uint64) *arena {
+	arenas := s.competitionArenas(competitionId)
+	var best *arena
+	bestLoad := 0
+	for _, a := range arenas {
+		candidate := &arena{a, s}
+		load := len(candidate.queue())
+		if best == nil || load < bestLoad {
+			best, bestLoad = candidate, load
+		}
+	}
+	return best
+}
+
+// unassigned returns the games of a competition waiting to be played that haven't been given an arena, in the order they were created
+func (s *store) unassigned(competitionId uint64) []pb.Game {
+	var tournaments []pb.Tournament
+	s.Select(q.Eq("CompetitionId", competitionId)).Find(&tournaments)
+	var tournamentIds []uint64
+	for _, t := range tournaments {
+		tournamentIds = append(tournamentIds, t.Id)
+	}
+	var rounds []pb.Round
+	s.Select(q.In("TournamentId", tournamentIds)).Find(&rounds)
+	var roundIds []uint64
+	for _, r := range rounds {
+		roundIds = append(roundIds, r.Id)
+	}
+	var games []pb.Game
+	s.Select(q.In("RoundId", roundIds), q.Eq("Status", pb.Status_NEW), q.Eq("ArenaId", uint32(0)), q.Eq("ParentId", uint64(0))).OrderBy("Id").Find(&games)
+	return games
+}
+
+func (c *competition) AssignArenas() error {
+	return c.command("AssignArenas", func(c *competition) error {
+		for _, g := range c.unassigned(c.Id) {
+			if c.playingTeams(g) < 2 {
+				continue // Byes don't need an arena
+			}
+			a := c.leastLoaded(c.Id)
+			if a == nil {
+				return models.ErrNoArenas
+			}
+			(&game{g, c.store}).SetArena(a)
+		}
+		return nil
+	})
+}
+
+// advanceQueue starts the next game at the arena of a game that has just finished. If nothing queued at the arena is ready,
+// the first ready game of the competition without an arena is brought to it. Games at idle arenas that were waiting on the teams of the finished game are started too
+func (g *game) advanceQueue() {
+	freed := &arena{store: g.store}
+	if g.One("Id", g.ArenaId, &freed.Arena) != nil {
+		return
+	}
+
+	if !freed.playing() {
+		next := freed.NextGame()
+		if next == nil {
+			for _, waiting := range g.unassigned(g.getTournament().CompetitionId) {
+				if g.ready(waiting) {
+					next = &game{waiting, g.store}
+					next.SetArena(freed)
+					break
+				}
+			}
+		}
+		if next != nil {
+			next.Start()
+		}
+	}
+
+	finished := map[uint64]bool{}
+	var gts []pb.GameTeam
+	g.Select(q.Eq("GameId", g.Id)).Find(&gts)
+	for _, gt := range gts {
+		finished[gt.TeamId] = true
+	}
+	for _, a := range g.competitionArenas(freed.CompetitionId) {
+		idle := &arena{a, g.store}
+		if a.Id == freed.Id || idle.playing() {
+			continue
+		}
+		next := idle.NextGame()
+		if next == nil {
+			continue
+		}
+		var waiting []pb.GameTeam
+		g.Select(q.Eq("GameId", next.(*game).Id)).Find(&waiting)
+		for _, gt := range waiting {
+			if finished[gt.TeamId] {
+				next.Start()
+				break
+			}
+		}
+	}
+}
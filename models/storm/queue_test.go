@@ -0,0 +1,78 @@
+package storm
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+func TestArenasStayInCompetition(t *testing.T) {
+	e := newTestEngine(t)
+	a, tourneyA := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 4, true)
+	b, tourneyB := newTestTournament(t, e, "B", models.TournamentType_ROUND_ROBIN, 2, true)
+	courtA := a.CreateArena("Court 1")
+	b.CreateArena("Court 1")
+	b.CreateArena("Court 2")
+
+	if arenas := a.GetArenas(); len(arenas) != 1 {
+		t.Fatalf("Competition A has %d arenas, expected 1", len(arenas))
+	}
+	if arenas := b.GetArenas(); len(arenas) != 2 {
+		t.Fatalf("Competition B has %d arenas, expected 2", len(arenas))
+	}
+
+	roundA, err := tourneyA.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := tourneyA.GetTeams()
+	roundA.CreateGame(teams[:2], true)
+	roundA.CreateGame(teams[2:], true)
+	if err := a.AssignArenas(); err != nil {
+		t.Fatal(err)
+	}
+	for i, g := range tourneyA.GetActiveRound().GetGames() {
+		if g.GetArena().GetName() != "Court 1" || len(courtA.GetGames()) != 2 {
+			t.Errorf("Game %d of A wasn't queued at A's only arena", i+1)
+		}
+	}
+
+	roundB, err := tourneyB.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	gameB := roundB.CreateGame(tourneyB.GetTeams(), true)
+	gameB.SetArena(b.GetArenas()[1])
+	if len(b.GetArenas()[1].GetGames()) != 1 || len(courtA.GetGames()) != 2 {
+		t.Errorf("Game of B was queued at the wrong arena")
+	}
+
+	// Finishing a game in A starts the next one there, without touching B's arenas
+	first := tourneyA.GetActiveRound().GetGames()[0]
+	first.Start()
+	first.SetScores([]int64{2, 1})
+	first.SetFinal()
+	if next := tourneyA.GetActiveRound().GetGames()[1]; next.GetStatus() != models.Status_ONGOING {
+		t.Errorf("Next game at A's arena wasn't started")
+	}
+	if tourneyB.GetActiveRound().GetGames()[0].GetStatus() != models.Status_NEW {
+		t.Errorf("Game of B was started by a game finishing in A")
+	}
+}
+
+func TestAssignArenasWithoutArenas(t *testing.T) {
+	e := newTestEngine(t)
+	c, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	if err := c.AssignArenas(); err != nil {
+		t.Errorf("Assigning arenas without any games waiting: %v", err)
+	}
+
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	round.CreateGame(tourney.GetTeams(), true)
+	if err := c.AssignArenas(); err != models.ErrNoArenas {
+		t.Errorf("Assigning arenas without any arenas returned %v, expected %v", err, models.ErrNoArenas)
+	}
+}
@@ -0,0 +1,156 @@
+package storm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+
+	"github.com/asdine/storm/q"
+)
+
+// series is a game played as a best-of-N. Sub-games are stored as games with the series game as their parent, and aren't part of any round
+type series struct {
+	*game
+}
+
+func (s *series) GetLength() uint32 {
+	return s.SeriesLength
+}
+
+func (s *series) GetGames() []models.Game {
+	var games []models.Game
+	err := s.Select(q.Eq("ParentId", s.Id)).Each(new(pb.Game), func(record interface{}) error {
+		g := record.(*pb.Game)
+		games = append(games, &game{*g, s.store})
+		return nil
+	})
+	if err != nil {
+		fmt.Println("Error getting games for this series:", err)
+	}
+
+	return games
+}
+
+func (s *series) NextGame() (models.Game, error) {
+	var next models.Game
+	err := s.command("NextGame", func(g *game) error {
+		var err error
+		next, err = (&series{g}).nextGame()
+		return err
+	})
+	return next, err
+}
+
+func (s *series) nextGame() (models.Game, error) {
+	if s.Status == pb.Status_COMPLETED || s.IsClinched() {
+		return nil, fmt.Errorf("Series has already been decided")
+	}
+	games := s.GetGames()
+	if uint32(len(games)) >= s.GetLength() {
+		return nil, fmt.Errorf("All %d games of the series have been played", s.GetLength())
+	}
+	if len(games) > 0 && games[len(games)-1].GetStatus() != models.Status_COMPLETED {
+		return nil, fmt.Errorf("Can't start the next game until the current game is completed")
+	}
+
+	sub := pb.Game{ParentId: s.Id, Status: pb.Status_NEW, Bracket: s.Bracket, ArenaId: s.ArenaId}
+	err := s.Save(&sub)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create game for the series: %w", err)
+	}
+	var gts []pb.GameTeam
+	s.Select(q.Eq("GameId", s.Id)).Find(&gts)
+	for _, gt := range gts {
+		subTeam := pb.GameTeam{GameId: sub.Id, TeamId: gt.TeamId}
+		s.Save(&subTeam)
+	}
+
+	return &game{sub, s.store}, nil
+}
+
+func (s *series) GetWins() []int64 {
+	wins, _ := s.tally()
+	return wins
+}
+
+func (s *series) IsClinched() bool {
+	wins, played := s.tally()
+	if len(wins) == 0 || played == 0 {
+		return false
+	}
+	sorted := make([]int64, len(wins))
+	copy(sorted, wins)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	if len(sorted) == 1 {
+		return true
+	}
+	remaining := int64(s.GetLength()) - played
+	return sorted[0] > sorted[1]+remaining
+}
+
+// tally counts how many completed sub-games each team has won, and how many sub-games have been completed
+func (s *series) tally() ([]int64, int64) {
+	var gts []pb.GameTeam
+	s.Select(q.Eq("GameId", s.Id)).Find(&gts)
+	teamIndex := map[uint64]int{}
+	for i, gt := range gts {
+		teamIndex[gt.TeamId] = i
+	}
+
+	wins := make([]int64, len(gts))
+	var played int64
+	for _, sub := range s.GetGames() {
+		if sub.GetStatus() != models.Status_COMPLETED {
+			continue
+		}
+		played++
+		var subTeams []pb.GameTeam
+		s.Select(q.Eq("GameId", sub.(*game).Id)).Find(&subTeams)
+		for _, gt := range subTeams {
+			if gt.Place == 0 {
+				wins[teamIndex[gt.TeamId]]++
+			}
+		}
+	}
+
+	return wins, played
+}
+
+// updateSeries carries the result of a completed sub-game up to its series, and completes the series once it has been decided
+func (g *game) updateSeries() {
+	var parent pb.Game
+	err := g.One("Id", g.ParentId, &parent)
+	if err != nil {
+		fmt.Println("Unable to find series for game:", err)
+		return
+	}
+	s := &series{&game{parent, g.store}}
+	wins, played := s.tally()
+	s.setScores(wins)
+
+	if !s.IsClinched() && played < int64(s.GetLength()) {
+		if s.Status == pb.Status_COMPLETED { // A correction took away a win the series was decided by
+			s.reopen()
+		}
+		return
+	}
+	s.setPlaces(rankScores(wins))
+	s.finalize()
+}
+
+// reopen puts a completed series back in play, clearing the result it was decided with
+func (s *series) reopen() {
+	s.Status = pb.Status_ONGOING
+	s.UpdateField(&s.game.Game, "Status", pb.Status_ONGOING)
+	s.FinishedCommand = 0
+	s.UpdateField(&s.game.Game, "FinishedCommand", uint64(0))
+
+	var gts []pb.GameTeam
+	s.Select(q.Eq("GameId", s.Id)).Find(&gts)
+	for _, gt := range gts {
+		s.UpdateField(&gt, "Place", int64(0))
+		s.UpdateField(&gt, "Result", pb.Result_UNDECIDED)
+	}
+}
@@ -0,0 +1,96 @@
+package storm
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// newTestSeries creates a best of three series between two teams
+func newTestSeries(t *testing.T) (models.Tournament, models.Series) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	round.SetSeriesLength(3)
+	series := round.CreateGame(tourney.GetTeams(), true).GetSeries()
+	if series == nil {
+		t.Fatal("Game of a round played as best of 3 isn't a series")
+	}
+	return tourney, series
+}
+
+// playSeriesGame plays the next game of a series with the scores given
+func playSeriesGame(t *testing.T, series models.Series, scores []int64) models.Game {
+	game, err := series.NextGame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game.SetScores(scores)
+	game.SetFinal()
+	return game
+}
+
+// storedSeries reads the series back
+func storedSeries(tourney models.Tournament) (models.Game, models.Series) {
+	game := tourney.GetActiveRound().GetGames()[0]
+	return game, game.GetSeries()
+}
+
+func TestSeriesClinched(t *testing.T) {
+	tourney, series := newTestSeries(t)
+	playSeriesGame(t, series, []int64{3, 1})
+	playSeriesGame(t, series, []int64{2, 0})
+
+	game, series := storedSeries(tourney)
+	if !series.IsClinched() || game.GetStatus() != models.Status_COMPLETED {
+		t.Errorf("Series won 2-0 isn't over")
+	}
+	if places := game.GetPlaces(); places[0] != 0 || places[1] != 1 {
+		t.Errorf("Places of the series are %v, expected [0 1]", places)
+	}
+	if _, err := series.NextGame(); err == nil {
+		t.Errorf("Expected an error playing on once the series was decided")
+	}
+}
+
+func TestSeriesNotClinched(t *testing.T) {
+	tourney, series := newTestSeries(t)
+	playSeriesGame(t, series, []int64{3, 1})
+	playSeriesGame(t, series, []int64{0, 2})
+
+	game, series := storedSeries(tourney)
+	if series.IsClinched() || game.GetStatus() == models.Status_COMPLETED {
+		t.Errorf("Series tied 1-1 is over")
+	}
+	if wins := series.GetWins(); wins[0] != 1 || wins[1] != 1 {
+		t.Errorf("Wins are %v, expected [1 1]", wins)
+	}
+	if _, err := series.NextGame(); err != nil {
+		t.Errorf("Unable to play the deciding game: %v", err)
+	}
+}
+
+func TestSeriesUnclinchedByCorrection(t *testing.T) {
+	tourney, series := newTestSeries(t)
+	playSeriesGame(t, series, []int64{3, 1})
+	second := playSeriesGame(t, series, []int64{2, 0})
+
+	if err := second.Correct("Ref", []int64{0, 2}, nil); err != nil {
+		t.Fatal(err)
+	}
+	game, series := storedSeries(tourney)
+	if series.IsClinched() || game.GetStatus() == models.Status_COMPLETED {
+		t.Errorf("Series is still over after the win that decided it was corrected")
+	}
+	if results := game.GetResults(); results[0] != models.Result_UNDECIDED || results[1] != models.Result_UNDECIDED {
+		t.Errorf("Results of the reopened series are %v, expected them to be undecided", results)
+	}
+	playSeriesGame(t, series, []int64{0, 1})
+	game, _ = storedSeries(tourney)
+	if places := game.GetPlaces(); game.GetStatus() != models.Status_COMPLETED || places[0] != 1 || places[1] != 0 {
+		t.Errorf("Deciding game didn't complete the series for the second team, places are %v", places)
+	}
+}
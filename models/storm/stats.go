@@ -0,0 +1,420 @@
+package storm
+
+import (
+	"sort"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+	formats "github.com/justinjudd/competition/tournament"
+
+	"github.com/asdine/storm/q"
+)
+
+// statKeys are the breakdowns a game or finish counts towards, besides the total
+type statKeys struct {
+	competition    string
+	tournamentType models.TournamentType
+	teammates      []string
+}
+
+// statsTally builds up PlayerStats
+type statsTally struct {
+	models.PlayerStats
+}
+
+func newStatsTally() *statsTally {
+	return &statsTally{models.PlayerStats{
+		ByCompetition:    map[string]models.Stats{},
+		ByTournamentType: map[models.TournamentType]models.Stats{},
+		ByTeammate:       map[string]models.Stats{},
+	}}
+}
+
+// add applies a change to the total and each of the breakdowns in keys
+func (t *statsTally) add(keys statKeys, change func(*models.Stats)) {
+	change(&t.Total)
+	s := t.ByCompetition[keys.competition]
+	change(&s)
+	t.ByCompetition[keys.competition] = s
+	s = t.ByTournamentType[keys.tournamentType]
+	change(&s)
+	t.ByTournamentType[keys.tournamentType] = s
+	for _, name := range keys.teammates {
+		s = t.ByTeammate[name]
+		change(&s)
+		t.ByTeammate[name] = s
+	}
+}
+
+// GetStats loads the records it needs with one query for each kind of record, rather than queries for each game, so it stays quick for long careers.
+// Finishes in round robin and Swiss tournaments come from their standings, which are only worked out for tournaments that are over
+func (p *player) GetStats() models.PlayerStats {
+	tally := newStatsTally()
+
+	var memberships []pb.PlayerTeam
+	p.Select(q.Eq("PlayerId", p.Id)).Find(&memberships)
+	ownTeams := map[uint64]bool{}
+	for _, m := range memberships {
+		ownTeams[m.TeamId] = true
+	}
+	if len(ownTeams) == 0 {
+		return tally.PlayerStats
+	}
+
+	var entries []pb.GameTeam
+	p.Select(q.In("TeamId", keys(ownTeams))).Find(&entries)
+	gameIds := map[uint64]bool{}
+	for _, e := range entries {
+		gameIds[e.GameId] = true
+	}
+	var games []pb.Game
+	if len(gameIds) > 0 {
+		p.Select(q.In("Id", keys(gameIds)), q.Eq("ParentId", uint64(0)), q.Eq("Status", pb.Status_COMPLETED)).OrderBy("Id").Find(&games)
+	}
+	completed := map[uint64]bool{}
+	roundIds := map[uint64]bool{}
+	for _, g := range games {
+		completed[g.Id] = true
+		roundIds[g.RoundId] = true
+	}
+
+	rounds, tournaments, competitions := p.loadStructure(roundIds)
+	gameTeams := p.loadGameTeams(completed)
+	rosters := p.loadRosters(gameTeams)
+	changes, members := p.loadRosterChanges(ownTeams, rosters)
+	names := p.loadNames(members)
+
+	// Which breakdowns the player's result with a team in a game counts towards. Teammates are the players on the roster for the game
+	keysFor := func(tournamentId uint64, teamId uint64, g pb.Game) statKeys {
+		t := tournaments[tournamentId]
+		k := statKeys{competition: competitions[t.CompetitionId].Name, tournamentType: models.TournamentType(t.Type)}
+		for _, playerId := range members[teamId] {
+			if playerId != p.Id && playedFor(changes[teamId][playerId], g) {
+				k.teammates = append(k.teammates, names[playerId])
+			}
+		}
+		return k
+	}
+
+	played := map[uint64]map[uint64]statKeys{} // Teams of the player in each tournament they played in, with the breakdowns of their last game
+	for _, g := range games {
+		teams := gameTeams[g.Id]
+		real := 0
+		var total int64
+		for _, gt := range teams {
+			if len(rosters[gt.TeamId]) > 0 {
+				real++
+			}
+			total += gt.Score
+		}
+		if real < 2 {
+			continue // A bye
+		}
+		t := tournaments[rounds[g.RoundId].TournamentId]
+		for _, gt := range teams {
+			if !ownTeams[gt.TeamId] || !playedFor(changes[gt.TeamId][p.Id], g) {
+				continue
+			}
+			if played[t.Id] == nil {
+				played[t.Id] = map[uint64]statKeys{}
+			}
+			k := keysFor(t.Id, gt.TeamId, g)
+			played[t.Id][gt.TeamId] = k
+			result, score := gt.Result, gt.Score
+			tally.add(k, func(s *models.Stats) {
+				s.Played++
+				switch result {
+				case pb.Result_WIN, pb.Result_WALKOVER:
+					s.Wins++
+				case pb.Result_LOSS, pb.Result_FORFEIT, pb.Result_DISQUALIFIED:
+					s.Losses++
+				case pb.Result_DRAW:
+					s.Draws++
+				}
+				if t.Scored {
+					s.PointsFor += score
+					s.PointsAgainst += total - score
+				}
+			})
+		}
+	}
+
+	// Titles and finishes come from the tournaments that are over
+	finished := map[uint64]bool{}
+	for id := range played {
+		if tournaments[id].Status == pb.Status_COMPLETED {
+			finished[id] = true
+		}
+	}
+	for id, places := range p.finishes(tournaments, finished) {
+		for teamId, k := range played[id] {
+			place, ok := places[teamId]
+			if !ok {
+				continue
+			}
+			tally.add(k, func(s *models.Stats) {
+				if place == 1 {
+					s.Titles++
+				}
+				if s.BestFinish == 0 || place < s.BestFinish {
+					s.BestFinish = place
+				}
+			})
+		}
+	}
+
+	return tally.PlayerStats
+}
+
+// keys returns the ids in a set
+func keys(set map[uint64]bool) []uint64 {
+	ids := make([]uint64, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// loadStructure loads rounds, and the tournaments and competitions they are in
+func (s *store) loadStructure(roundIds map[uint64]bool) (map[uint64]pb.Round, map[uint64]pb.Tournament, map[uint64]pb.Competition) {
+	rounds := map[uint64]pb.Round{}
+	tournaments := map[uint64]pb.Tournament{}
+	competitions := map[uint64]pb.Competition{}
+	if len(roundIds) == 0 {
+		return rounds, tournaments, competitions
+	}
+
+	var rs []pb.Round
+	s.Select(q.In("Id", keys(roundIds))).Find(&rs)
+	tournamentIds := map[uint64]bool{}
+	for _, r := range rs {
+		rounds[r.Id] = r
+		tournamentIds[r.TournamentId] = true
+	}
+	var ts []pb.Tournament
+	s.Select(q.In("Id", keys(tournamentIds))).Find(&ts)
+	competitionIds := map[uint64]bool{}
+	for _, t := range ts {
+		tournaments[t.Id] = t
+		competitionIds[t.CompetitionId] = true
+	}
+	var cs []pb.Competition
+	s.Select(q.In("Id", keys(competitionIds))).Find(&cs)
+	for _, c := range cs {
+		competitions[c.Id] = c
+	}
+	return rounds, tournaments, competitions
+}
+
+// loadGameTeams loads the teams of games, in the order they were added to each game
+func (s *store) loadGameTeams(gameIds map[uint64]bool) map[uint64][]pb.GameTeam {
+	gameTeams := map[uint64][]pb.GameTeam{}
+	if len(gameIds) == 0 {
+		return gameTeams
+	}
+	var gts []pb.GameTeam
+	s.Select(q.In("GameId", keys(gameIds))).OrderBy("Id").Find(&gts)
+	for _, gt := range gts {
+		gameTeams[gt.GameId] = append(gameTeams[gt.GameId], gt)
+	}
+	return gameTeams
+}
+
+// loadRosters loads the current players of every team in the games. Teams without players are byes
+func (s *store) loadRosters(gameTeams map[uint64][]pb.GameTeam) map[uint64][]uint64 {
+	rosters := map[uint64][]uint64{}
+	teamIds := map[uint64]bool{}
+	for _, gts := range gameTeams {
+		for _, gt := range gts {
+			teamIds[gt.TeamId] = true
+		}
+	}
+	if len(teamIds) == 0 {
+		return rosters
+	}
+	var pts []pb.PlayerTeam
+	s.Select(q.In("TeamId", keys(teamIds)), q.Eq("Removed", false)).Find(&pts)
+	for _, pt := range pts {
+		rosters[pt.TeamId] = append(rosters[pt.TeamId], pt.PlayerId)
+	}
+	return rosters
+}
+
+// loadRosterChanges loads the roster changes of the teams for each of their players, in the order they were made. Also returns everyone who has
+// been on each team, including the players on the rosters that were added before changes were kept
+func (s *store) loadRosterChanges(teamIds map[uint64]bool, rosters map[uint64][]uint64) (map[uint64]map[uint64][]pb.RosterChange, map[uint64][]uint64) {
+	changes := map[uint64]map[uint64][]pb.RosterChange{}
+	members := map[uint64][]uint64{}
+	var rcs []pb.RosterChange
+	s.Select(q.In("TeamId", keys(teamIds))).OrderBy("Id").Find(&rcs)
+	for _, rc := range rcs {
+		if changes[rc.TeamId] == nil {
+			changes[rc.TeamId] = map[uint64][]pb.RosterChange{}
+		}
+		if changes[rc.TeamId][rc.PlayerId] == nil {
+			members[rc.TeamId] = append(members[rc.TeamId], rc.PlayerId)
+		}
+		changes[rc.TeamId][rc.PlayerId] = append(changes[rc.TeamId][rc.PlayerId], rc)
+	}
+	for teamId := range teamIds {
+		for _, playerId := range rosters[teamId] {
+			if changes[teamId][playerId] == nil {
+				members[teamId] = append(members[teamId], playerId)
+			}
+		}
+	}
+	return changes, members
+}
+
+// loadNames loads the names of the players on the teams
+func (s *store) loadNames(rosters map[uint64][]uint64) map[uint64]string {
+	names := map[uint64]string{}
+	playerIds := map[uint64]bool{}
+	for _, players := range rosters {
+		for _, id := range players {
+			playerIds[id] = true
+		}
+	}
+	if len(playerIds) == 0 {
+		return names
+	}
+	var players []pb.Player
+	s.Select(q.In("Id", keys(playerIds))).Find(&players)
+	for _, p := range players {
+		names[p.Id] = p.Name
+	}
+	return names
+}
+
+// finishes works out the place every team finished in each of the tournaments, starting at 1. Teams that can't be separated share a place
+func (s *store) finishes(tournaments map[uint64]pb.Tournament, ids map[uint64]bool) map[uint64]map[uint64]int {
+	places := map[uint64]map[uint64]int{}
+	if len(ids) == 0 {
+		return places
+	}
+
+	var rounds []pb.Round
+	s.Select(q.In("TournamentId", keys(ids))).OrderBy("Id").Find(&rounds)
+	roundIds := map[uint64]bool{}
+	roundNumber := map[uint64]int{}
+	counts := map[uint64]int{}
+	for _, r := range rounds {
+		roundIds[r.Id] = true
+		roundNumber[r.Id] = counts[r.TournamentId]
+		counts[r.TournamentId]++
+	}
+	var games []pb.Game
+	if len(roundIds) > 0 {
+		s.Select(q.In("RoundId", keys(roundIds)), q.Eq("ParentId", uint64(0))).OrderBy("Id").Find(&games)
+	}
+	gameIds := map[uint64]bool{}
+	for _, g := range games {
+		gameIds[g.Id] = true
+	}
+	gameTeams := s.loadGameTeams(gameIds)
+	rosters := s.loadRosters(gameTeams)
+
+	tournamentOf := map[uint64]uint64{}
+	for _, r := range rounds {
+		tournamentOf[r.Id] = r.TournamentId
+	}
+	byTournament := map[uint64][]pb.Game{}
+	for _, g := range games {
+		byTournament[tournamentOf[g.RoundId]] = append(byTournament[tournamentOf[g.RoundId]], g)
+	}
+
+	for id := range ids {
+		t := tournaments[id]
+		var finish map[uint64]int
+		switch models.TournamentType(t.Type) {
+		case models.TournamentType_ROUND_ROBIN, models.TournamentType_SWISS_FORMAT, models.TournamentType_GROUP_PLAY:
+			finish = tableFinish(&tournament{t, s})
+		default:
+			wrapped, err := formats.Wrap(&tournament{t, s})
+			if err != nil {
+				continue
+			}
+			finish = eliminationFinish(wrapped.GetBracketOrder(), byTournament[id], gameTeams, rosters, roundNumber)
+		}
+		places[id] = finish
+	}
+	return places
+}
+
+// rank turns the teams, sorted best first, into places. better reports whether one team finished ahead of another
+func rank(teams []uint64, better func(a, b uint64) bool) map[uint64]int {
+	sort.SliceStable(teams, func(i, j int) bool { return better(teams[i], teams[j]) })
+	places := map[uint64]int{}
+	for i, team := range teams {
+		if i > 0 && !better(teams[i-1], team) {
+			places[team] = places[teams[i-1]]
+			continue
+		}
+		places[team] = i + 1
+	}
+	return places
+}
+
+// tableFinish places teams in the order of the tournament's standings. Teams level on every tiebreak share a place
+func tableFinish(t models.Tournament) map[uint64]int {
+	standings := formats.Standings(t)
+	places := map[uint64]int{}
+	for i, st := range standings {
+		id := st.Team.(*team).Id
+		if i > 0 && tableLevel(standings[i-1], st) {
+			places[id] = places[standings[i-1].Team.(*team).Id]
+			continue
+		}
+		places[id] = i + 1
+	}
+	return places
+}
+
+// tableLevel reports whether two teams couldn't be separated by any of the standings' tiebreaks
+func tableLevel(a formats.Standing, b formats.Standing) bool {
+	return a.Withdrawn == b.Withdrawn && a.Wins+a.Walkovers == b.Wins+b.Walkovers && a.Draws == b.Draws &&
+		a.HeadToHead == b.HeadToHead && a.PointsFor-a.PointsAgainst == b.PointsFor-b.PointsAgainst
+}
+
+// eliminationFinish places teams by how far they got: the later the last round a team played in, the better,
+// then by how important the bracket of that game is, then whether they won it
+func eliminationFinish(bracketOrder []string, games []pb.Game, gameTeams map[uint64][]pb.GameTeam, rosters map[uint64][]uint64, roundNumber map[uint64]int) map[uint64]int {
+	importance := map[string]int{}
+	for i, b := range bracketOrder {
+		importance[b] = i
+	}
+	type last struct {
+		round, bracket int
+		won            bool
+	}
+	lasts := map[uint64]last{}
+	for _, g := range games {
+		bracket, ok := importance[g.Bracket]
+		if !ok {
+			bracket = len(importance)
+		}
+		for _, gt := range gameTeams[g.Id] {
+			if len(rosters[gt.TeamId]) == 0 {
+				continue
+			}
+			won := gt.Result == pb.Result_WIN || gt.Result == pb.Result_WALKOVER
+			lasts[gt.TeamId] = last{roundNumber[g.RoundId], bracket, won} // Games are in the order they were played, so the last one seen is the team's last game
+		}
+	}
+
+	teams := make([]uint64, 0, len(lasts))
+	for id := range lasts {
+		teams = append(teams, id)
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i] < teams[j] })
+	return rank(teams, func(a, b uint64) bool {
+		la, lb := lasts[a], lasts[b]
+		if la.round != lb.round {
+			return la.round > lb.round
+		}
+		if la.bracket != lb.bracket {
+			return la.bracket < lb.bracket
+		}
+		return la.won && !lb.won
+	})
+}
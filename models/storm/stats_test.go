@@ -0,0 +1,102 @@
+package storm
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+	formats "github.com/justinjudd/competition/tournament"
+)
+
+func TestStatsHeadToHeadFinish(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 4, true)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := tourney.GetTeams()
+	// Teams 1 and 2 both win twice. Team 2 has the better differential, but team 1 beat them
+	for _, g := range []struct {
+		home, away int
+		homeScore  int64
+		awayScore  int64
+	}{
+		{0, 1, 1, 0},
+		{1, 2, 10, 0},
+		{1, 3, 10, 0},
+		{0, 2, 1, 0},
+		{3, 0, 1, 0},
+		{2, 3, 1, 0},
+	} {
+		game := round.CreateGame([]models.Team{teams[g.home], teams[g.away]}, true)
+		game.SetScores([]int64{g.homeScore, g.awayScore})
+		game.SetFinal()
+	}
+	round.SetFinal()
+	tourney.SetStatus(models.Status_COMPLETED)
+
+	for i, expected := range []int{1, 2, 3, 4} {
+		stats := teams[i].GetPlayers()[0].GetStats()
+		if stats.Total.BestFinish != expected {
+			t.Errorf("%s finished %d, expected %d", teams[i].GetName(), stats.Total.BestFinish, expected)
+		}
+		if titles := stats.Total.Titles; (titles == 1) != (expected == 1) {
+			t.Errorf("%s has %d titles", teams[i].GetName(), titles)
+		}
+	}
+}
+
+func TestStatsCompassFinish(t *testing.T) {
+	e := newTestEngine(t)
+	_, base := newTestTournament(t, e, "A", models.TournamentType_COMPASS_DRAW, 4, false)
+	tourney, err := formats.Wrap(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for {
+		round, err := tourney.NextRound()
+		if err != nil {
+			break
+		}
+		for _, game := range round.GetGames() {
+			game.SetPlaces([]int64{0, 1})
+			game.SetFinal()
+		}
+		round.SetFinal()
+	}
+	tourney.SetStatus(models.Status_COMPLETED)
+
+	finishes := map[int]string{}
+	for _, team := range tourney.GetTeams() {
+		stats := team.GetPlayers()[0].GetStats()
+		if other, ok := finishes[stats.Total.BestFinish]; ok {
+			t.Errorf("%s and %s both finished %d", team.GetName(), other, stats.Total.BestFinish)
+		}
+		finishes[stats.Total.BestFinish] = team.GetName()
+	}
+	if len(finishes) != 4 {
+		t.Errorf("Finishes %v, expected 4 different places", finishes)
+	}
+}
+
+func TestStatsSkipRemovedTeammates(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	teams := tourney.GetTeams()
+	reserve := e.CreatePlayer("Reserve", nil)
+	teams[0].AddPlayer(reserve)
+	teams[0].RemovePlayer(reserve)
+
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := round.CreateGame(teams, true)
+	game.SetScores([]int64{2, 1})
+	game.SetFinal()
+
+	stats := e.GetPlayer("A 1").GetStats()
+	if _, ok := stats.ByTeammate["Reserve"]; ok {
+		t.Errorf("Removed player counted as a teammate: %v", stats.ByTeammate)
+	}
+}
@@ -0,0 +1,347 @@
+package storm
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/justinjudd/competition/models/storm/pb"
+
+	"github.com/asdine/storm"
+	"github.com/asdine/storm/q"
+)
+
+const (
+	undoCommand = "Undo"
+	redoCommand = "Redo"
+)
+
+// command is a command being recorded, with the changes to games it made that haven't been sent to subscribers yet
+type command struct {
+	id      uint64
+	pending []pendingChange
+	ended   int32 // Set once the command is over, records made during it start commands of their own from then on
+}
+
+// recording reports whether changes made through the store are part of a command that hasn't ended
+func (s *store) recording() bool {
+	return s.current != nil && atomic.LoadInt32(&s.current.ended) == 0
+}
+
+// withCommand records fn as a command made in a competition. fn makes its changes through the copy of the store it is given, and records
+// read or created through that copy make theirs as part of the command too. Records from outside of fn have to be joined to the command
+// before making changes, like the games of a round's bookings, or they wait for it to end. Called on a store that is already recording
+// a command, fn is run as part of that command. If fn fails, the changes it made are reverted
+func (s *store) withCommand(name string, competition uint64, fn func(s *store) error) error {
+	return s.recordCommand(pb.Command{Name: name, CompetitionId: competition}, fn)
+}
+
+// recordCommand is withCommand for commands that apply to an earlier command, like undo
+func (s *store) recordCommand(c pb.Command, fn func(s *store) error) error {
+	if s.recording() {
+		return fn(s)
+	}
+	pending, err := s.runCommand(c, fn)
+	s.notify(pending)
+	return err
+}
+
+// runCommand records a new command while no other command is being recorded, returning the changes to games it made
+func (s *store) runCommand(c pb.Command, fn func(s *store) error) ([]pendingChange, error) {
+	s.commands.Lock()
+	defer s.commands.Unlock()
+
+	c.Timestamp = time.Now().UnixNano()
+	err := s.Node.Save(&c)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to record command: %w", err)
+	}
+	scoped := *s
+	scoped.current = &command{id: c.Id}
+	defer atomic.StoreInt32(&scoped.current.ended, 1)
+
+	err = scoped.logEvent(pb.EventAction_CREATED, &c, "")
+	if err == nil {
+		err = fn(&scoped)
+	}
+	if err != nil {
+		if revertErr := scoped.abandon(&c); revertErr != nil {
+			err = fmt.Errorf("%w, and its changes couldn't be reverted: %v", err, revertErr)
+		}
+		return nil, err
+	}
+	return scoped.current.pending, nil
+}
+
+// abandon reverts the changes of a command that failed, and marks it undone so it is passed over by undo and redo
+func (s *store) abandon(c *pb.Command) error {
+	events := s.commandEvents(c.Id)
+	for i := len(events) - 1; i >= 0; i-- {
+		err := s.revert(events[i])
+		if err != nil {
+			return err
+		}
+	}
+	return s.UpdateField(c, "Undone", true)
+}
+
+func (e *engine) command(name string, fn func(e *engine) error) error {
+	return e.withCommand(name, 0, func(s *store) error {
+		return fn(&engine{s})
+	})
+}
+
+// The command functions of each kind of record run fn as a command, with a copy of the record that makes its changes as part of it.
+// Fields fn sets on the copy are kept if it succeeds
+
+func (c *competition) command(name string, fn func(c *competition) error) error {
+	return c.withCommand(name, c.Id, func(s *store) error {
+		scoped := &competition{c.Competition, s}
+		err := fn(scoped)
+		if err == nil {
+			c.Competition = scoped.Competition
+		}
+		return err
+	})
+}
+
+func (p *player) command(name string, fn func(p *player) error) error {
+	return p.withCommand(name, 0, func(s *store) error {
+		scoped := &player{p.Player, s}
+		err := fn(scoped)
+		if err == nil {
+			p.Player = scoped.Player
+		}
+		return err
+	})
+}
+
+func (t *tournament) command(name string, fn func(t *tournament) error) error {
+	return t.withCommand(name, t.CompetitionId, func(s *store) error {
+		scoped := &tournament{t.Tournament, s}
+		err := fn(scoped)
+		if err == nil {
+			t.Tournament = scoped.Tournament
+		}
+		return err
+	})
+}
+
+func (t *team) command(name string, fn func(t *team) error) error {
+	return t.withCommand(name, t.competitionId(), func(s *store) error {
+		scoped := &team{t.Team, s}
+		err := fn(scoped)
+		if err == nil {
+			t.Team = scoped.Team
+		}
+		return err
+	})
+}
+
+func (r *round) command(name string, fn func(r *round) error) error {
+	return r.withCommand(name, r.competitionId(), func(s *store) error {
+		scoped := &round{r.Round, s}
+		err := fn(scoped)
+		if err == nil {
+			r.Round = scoped.Round
+		}
+		return err
+	})
+}
+
+func (g *game) command(name string, fn func(g *game) error) error {
+	return g.withCommand(name, g.competitionId(), func(s *store) error {
+		return g.join(s, fn)
+	})
+}
+
+// join runs fn with a copy of the game that makes its changes through s, for games passed in to a command, like the games of a round's bookings
+func (g *game) join(s *store, fn func(g *game) error) error {
+	scoped := &game{g.Game, s}
+	err := fn(scoped)
+	if err == nil {
+		g.Game = scoped.Game
+	}
+	return err
+}
+
+// tournamentCompetition returns the id of the competition a tournament is in
+func (s *store) tournamentCompetition(tournamentId uint64) uint64 {
+	var t pb.Tournament
+	if err := s.One("Id", tournamentId, &t); err != nil {
+		return 0
+	}
+	return t.CompetitionId
+}
+
+func (r *round) competitionId() uint64 {
+	return r.tournamentCompetition(r.TournamentId)
+}
+
+func (t *team) competitionId() uint64 {
+	return t.tournamentCompetition(t.TournamentId)
+}
+
+func (g *game) competitionId() uint64 {
+	current := g.Game
+	for current.ParentId != 0 { // Sub-games of a series are in the round of their series
+		if err := g.One("Id", current.ParentId, &current); err != nil {
+			return 0
+		}
+	}
+	var r pb.Round
+	if err := g.One("Id", current.RoundId, &r); err != nil {
+		return 0
+	}
+	return g.tournamentCompetition(r.TournamentId)
+}
+
+func (c *competition) Undo() error {
+	return c.undo(c.Id)
+}
+
+func (c *competition) Redo() error {
+	return c.redo(c.Id)
+}
+
+// undo reverts the changes of the most recent command made in the competition that hasn't been undone. Commands that didn't change anything are passed over
+func (s *store) undo(competition uint64) error {
+	var commands []pb.Command
+	s.Select(q.Eq("CompetitionId", competition), q.Eq("Undone", false), q.Not(q.In("Name", []string{undoCommand, redoCommand}))).Reverse().Find(&commands)
+	var target *pb.Command
+	var events []pb.Event
+	for i := range commands {
+		events = s.commandEvents(commands[i].Id)
+		if len(events) > 0 {
+			target = &commands[i]
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("Nothing to undo")
+	}
+
+	return s.recordCommand(pb.Command{Name: undoCommand, Target: target.Id, CompetitionId: competition}, func(s *store) error {
+		return s.inTransaction(func(tx *store) error {
+			for i := len(events) - 1; i >= 0; i-- {
+				err := tx.revert(events[i])
+				if err != nil {
+					return fmt.Errorf("Unable to undo %s: %w", target.Name, err)
+				}
+			}
+			return tx.UpdateField(target, "Undone", true)
+		})
+	})
+}
+
+// redo applies the changes of the most recently undone command in the competition again. Once a new command that changes anything has been recorded, undone commands can't be redone
+func (s *store) redo(competition uint64) error {
+	var commands []pb.Command
+	s.Select(q.Eq("CompetitionId", competition)).Reverse().Find(&commands)
+
+	var target *pb.Command
+	for _, c := range commands {
+		if c.Name == redoCommand || c.Name != undoCommand && c.Undone { // Commands that failed were undone as they were made
+			continue
+		}
+		if c.Name != undoCommand {
+			if len(s.commandEvents(c.Id)) == 0 {
+				continue
+			}
+			break // Something new was done since the last undo
+		}
+		var undone pb.Command
+		if s.One("Id", c.Target, &undone) == nil && undone.Undone {
+			target = &undone
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("Nothing to redo")
+	}
+
+	return s.recordCommand(pb.Command{Name: redoCommand, Target: target.Id, CompetitionId: competition}, func(s *store) error {
+		return s.inTransaction(func(tx *store) error {
+			for _, ev := range tx.commandEvents(target.Id) {
+				record, err := decodeEvent(tx.Node, ev.Kind, ev.Data)
+				if err != nil {
+					return err
+				}
+				if ev.Action == pb.EventAction_DELETED {
+					err = tx.DeleteStruct(record)
+				} else {
+					err = tx.Save(record)
+				}
+				if err != nil {
+					return fmt.Errorf("Unable to redo %s: %w", target.Name, err)
+				}
+			}
+			return tx.UpdateField(target, "Undone", false)
+		})
+	})
+}
+
+// inTransaction runs fn against a copy of the store that makes all of its changes in a single transaction. If fn fails none of the changes are kept
+func (s *store) inTransaction(fn func(tx *store) error) error {
+	node, err := s.Begin(true)
+	if err != nil {
+		return fmt.Errorf("Unable to start transaction: %w", err)
+	}
+	tx := *s
+	tx.Node = node
+	err = fn(&tx)
+	if err != nil {
+		node.Rollback()
+		return err
+	}
+	err = node.Commit()
+	if err != nil {
+		return fmt.Errorf("Unable to save changes: %w", err)
+	}
+	return nil
+}
+
+// commandEvents returns the events recorded for a command, leaving out the bookkeeping of the commands themselves
+func (s *store) commandEvents(command uint64) []pb.Event {
+	var events []pb.Event
+	s.Select(q.Eq("Command", command), q.Not(q.Eq("Kind", "Command"))).Find(&events)
+	return events
+}
+
+// revert puts a record back to how it was before the event
+func (s *store) revert(ev pb.Event) error {
+	if ev.Action == pb.EventAction_CREATED {
+		record, err := decodeEvent(s.Node, ev.Kind, ev.Data)
+		if err != nil {
+			return err
+		}
+		return s.DeleteStruct(record)
+	}
+
+	previous := ev // Deleted records are restored from the copy stored with the event
+	if ev.Action == pb.EventAction_UPDATED {
+		err := s.Select(q.Eq("Kind", ev.Kind), q.Eq("RecordId", ev.RecordId), q.Lt("Id", ev.Id)).Reverse().First(&previous)
+		if err != nil {
+			return fmt.Errorf("No earlier version of %s %d: %w", ev.Kind, ev.RecordId, err)
+		}
+	}
+	record, err := decodeEvent(s.Node, previous.Kind, previous.Data)
+	if err != nil {
+		return err
+	}
+	return s.Save(record)
+}
+
+// decodeEvent decodes the record stored with an event
+func decodeEvent(db storm.Node, kind string, data []byte) (interface{}, error) {
+	newRecord, ok := eventKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("Unknown kind of record: %s", kind)
+	}
+	record := newRecord()
+	err := db.Codec().Unmarshal(data, record)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to decode %s: %w", kind, err)
+	}
+	return record, nil
+}
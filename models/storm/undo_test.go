@@ -0,0 +1,93 @@
+package storm
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+func TestUndoStaysInCompetition(t *testing.T) {
+	e := newTestEngine(t)
+	a, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	b, _ := newTestTournament(t, e, "B", models.TournamentType_ROUND_ROBIN, 2, true)
+
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := round.CreateGame(tourney.GetTeams(), true)
+	game.SetScores([]int64{3, 1})
+	b.CreateArena("Court 1")
+
+	if err := a.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.GetArenas()) != 1 {
+		t.Errorf("Undo in one competition removed an arena from another")
+	}
+	if scores := tourney.GetActiveRound().GetGames()[0].GetScores(); scores[0] != 0 || scores[1] != 0 {
+		t.Errorf("Scores after undo are %v, expected them to be cleared", scores)
+	}
+
+	if err := b.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.GetArenas()) != 0 {
+		t.Errorf("Undo didn't remove the arena")
+	}
+	if err := b.Redo(); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.GetArenas()) != 1 {
+		t.Errorf("Redo didn't restore the arena")
+	}
+}
+
+func TestUndoSkipsRejectedCommands(t *testing.T) {
+	e := newTestEngine(t)
+	c, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	game := round.CreateGame(tourney.GetTeams(), true)
+	game.SetScores([]int64{3, 1})
+	game.SetFinal()
+	game = tourney.GetActiveRound().GetGames()[0]
+	game.SetScores([]int64{0, 5}) // Rejected, the game is final
+
+	if err := c.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	game = tourney.GetActiveRound().GetGames()[0]
+	if game.GetStatus() == models.Status_COMPLETED {
+		t.Errorf("Undo passed over setting the game final")
+	}
+	if scores := game.GetScores(); scores[0] != 3 || scores[1] != 1 {
+		t.Errorf("Scores after undo are %v, expected [3 1]", scores)
+	}
+}
+
+func TestCreateGameStaysInCompetition(t *testing.T) {
+	e := newTestEngine(t)
+	_, tourney := newTestTournament(t, e, "A", models.TournamentType_ROUND_ROBIN, 2, true)
+	b, _ := newTestTournament(t, e, "B", models.TournamentType_ROUND_ROBIN, 2, true)
+
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.CreateArena("Court 1")
+	round.CreateGame(tourney.GetTeams(), true)
+
+	if err := b.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if len(tourney.GetActiveRound().GetGames()) != 1 {
+		t.Errorf("Undo in one competition removed a game from another")
+	}
+	if len(b.GetArenas()) != 0 {
+		t.Errorf("Undo didn't remove the arena")
+	}
+}
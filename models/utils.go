@@ -31,3 +31,23 @@ func IsByeGame(g Game, advance int) bool {
 	}
 	return false
 }
+
+// PlaysIn determines if an official's own team is in a game, either the team officiating or a team the official plays on
+func PlaysIn(o Official, g Game) bool {
+	for _, t := range g.GetTeams() {
+		if IsByeTeam(t) {
+			continue
+		}
+		if team := o.GetTeam(); team != nil && team.Equals(t) {
+			return true
+		}
+		if p := o.GetPlayer(); p != nil {
+			for _, member := range t.GetPlayers() {
+				if member.GetName() == p.GetName() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
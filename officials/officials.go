@@ -0,0 +1,191 @@
+// Package officials assigns referees, scorekeepers and other officials to the games of a round.
+//
+// Each role of each game goes to the official with the fewest games who is available: not playing in the game, free when it is scheduled,
+// and not already busy with another game at the same time. Games of a round that haven't been scheduled are taken to be played at the same time
+package officials
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/schedule"
+)
+
+// Official is an official with the windows when they are available
+type Official struct {
+	Official models.Official
+	Windows  []schedule.Window // Available at any time if empty
+}
+
+// Options describe the officials to choose from and what each game needs
+type Options struct {
+	Roles     []string   // Roles every game needs filled, eg "Referee" and "Scorekeeper"
+	Officials []Official // When several officials have as few games, earlier officials are chosen first
+}
+
+// candidate is an official being considered, with the games they already have
+type candidate struct {
+	Official
+	load  int
+	games []models.Game // Games the official officiates or plays in
+}
+
+// Round gives every game of the round that still needs to be played an official for each role it doesn't have filled yet
+func Round(round models.Round, opts Options) ([]models.Assignment, error) {
+	assignments, err := Plan(round, opts)
+	if err != nil {
+		return nil, err
+	}
+	round.AssignOfficials(assignments)
+	return assignments, nil
+}
+
+// Plan works out the officials for the games of a round without assigning them. Games that are over, and byes, aren't given officials
+func Plan(round models.Round, opts Options) ([]models.Assignment, error) {
+	if len(opts.Roles) == 0 {
+		return nil, fmt.Errorf("No roles to assign officials to")
+	}
+	if len(opts.Officials) == 0 {
+		return nil, fmt.Errorf("No officials to assign")
+	}
+
+	candidates := make([]*candidate, len(opts.Officials))
+	for i, o := range opts.Officials {
+		c := &candidate{Official: o}
+		for _, g := range o.Official.GetGames() {
+			c.load++
+			c.games = append(c.games, g)
+		}
+		if t := o.Official.GetTeam(); t != nil {
+			c.games = append(c.games, t.GetRecords()...)
+		}
+		if p := o.Official.GetPlayer(); p != nil {
+			c.games = append(c.games, p.GetRecords()...)
+		}
+		candidates[i] = c
+	}
+
+	games := round.GetGames()
+	var assignments []models.Assignment
+	for _, g := range games {
+		if g.GetStatus() == models.Status_COMPLETED || models.IsByeGame(g, 1) {
+			continue
+		}
+		filled := map[string]bool{}
+		var working []models.Official // Officials already given a role in this game
+		for _, a := range g.GetOfficials() {
+			filled[a.Role] = true
+			working = append(working, a.Official)
+		}
+
+		for _, role := range opts.Roles {
+			if filled[role] {
+				continue
+			}
+			var best *candidate
+			for _, c := range candidates {
+				if contains(working, c.Official.Official) || !c.available(g, games) {
+					continue
+				}
+				if best == nil || c.load < best.load {
+					best = c
+				}
+			}
+			if best == nil {
+				return nil, fmt.Errorf("No official available to be %s for %s", role, describe(g))
+			}
+			best.load++
+			best.games = append(best.games, g)
+			working = append(working, best.Official.Official)
+			assignments = append(assignments, models.Assignment{Game: g, Official: best.Official.Official, Role: role})
+		}
+	}
+	return assignments, nil
+}
+
+// available reports whether the official can take a game
+func (c *candidate) available(g models.Game, round []models.Game) bool {
+	if models.PlaysIn(c.Official.Official, g) {
+		return false
+	}
+	start, end := g.GetSchedule()
+	if start.IsZero() {
+		// Unscheduled games of a round could all be played at once, so the official can't be busy with another game in the round
+		for _, other := range c.games {
+			if other.GetStatus() != models.Status_COMPLETED && inRound(other, round) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if len(c.Windows) > 0 && !within(start, end, c.Windows) {
+		return false
+	}
+	for _, other := range c.games {
+		if other.GetStatus() == models.Status_COMPLETED {
+			continue
+		}
+		otherStart, otherEnd := other.GetSchedule()
+		if otherStart.IsZero() && inRound(other, round) {
+			return false
+		}
+		if !otherStart.IsZero() && start.Before(otherEnd) && otherStart.Before(end) {
+			return false
+		}
+	}
+	return true
+}
+
+// within reports whether a time slot is entirely inside one of the windows
+func within(start time.Time, end time.Time, windows []schedule.Window) bool {
+	for _, w := range windows {
+		if !start.Before(w.Start) && !end.After(w.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// inRound reports whether a game is one of the round's games
+func inRound(g models.Game, round []models.Game) bool {
+	for _, other := range round {
+		if sameGame(g, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameGame compares games by their teams, as games can't be compared directly
+func sameGame(a models.Game, b models.Game) bool {
+	at, bt := a.GetTeams(), b.GetTeams()
+	if len(at) != len(bt) {
+		return false
+	}
+	for i := range at {
+		if models.IsByeTeam(at[i]) != models.IsByeTeam(bt[i]) || (!models.IsByeTeam(at[i]) && !at[i].Equals(bt[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(officials []models.Official, o models.Official) bool {
+	for _, other := range officials {
+		if other.GetName() == o.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+func describe(g models.Game) string {
+	var names []string
+	for _, t := range g.GetTeams() {
+		names = append(names, t.GetName())
+	}
+	return strings.Join(names, " vs ")
+}
@@ -0,0 +1,108 @@
+package officials_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/officials"
+	"github.com/justinjudd/competition/schedule"
+)
+
+// newRound creates a round of two games between four single player teams, Ann v Bob and Cal v Dee, in a competition with an arena
+func newRound(t *testing.T) (models.Competition, models.Round) {
+	e := testutil.NewEngine(t)
+	c := e.CreateCompetition("League", nil)
+	c.CreateArena("Court 1")
+	tourney := c.AddTournament("Open", models.TournamentType_ROUND_ROBIN, nil, false, 2, 1, false)
+	for _, name := range []string{"Ann", "Bob", "Cal", "Dee"} {
+		tourney.CreateTeam(name, []models.Player{e.CreatePlayer(name, nil)}, nil)
+	}
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	teams := tourney.GetTeams()
+	round.CreateGame(teams[:2], false)
+	round.CreateGame(teams[2:], false)
+	return c, round
+}
+
+// playerOfficial adds an official who plays as the player with the same name
+func playerOfficial(c models.Competition, name string) models.Official {
+	for _, t := range c.GetAllTournaments()[0].GetTeams() {
+		for _, p := range t.GetPlayers() {
+			if p.GetName() == name {
+				return c.CreateOfficial(name, p)
+			}
+		}
+	}
+	return c.CreateOfficial(name, nil)
+}
+
+func TestRound(t *testing.T) {
+	c, round := newRound(t)
+	ref := c.CreateOfficial("Ref", nil)
+	ann := playerOfficial(c, "Ann")
+	sam := c.CreateOfficial("Sam", nil)
+
+	// Ann is playing while both games could be on, and Ref can't do both games as they could be played at the same time
+	assignments, err := officials.Round(round, officials.Options{Roles: []string{"Referee"}, Officials: []officials.Official{{Official: ann}, {Official: ref}, {Official: sam}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assignments) != 2 {
+		t.Fatalf("Made %d assignments, expected 2", len(assignments))
+	}
+	for i, expected := range []string{"Ref", "Sam"} {
+		assigned := round.GetGames()[i].GetOfficials()
+		if len(assigned) != 1 || assigned[0].Official.GetName() != expected || assigned[0].Role != "Referee" {
+			t.Errorf("Game %d has officials %v, expected %s refereeing", i+1, assigned, expected)
+		}
+	}
+
+	// Roles that are filled are left alone
+	assignments, err = officials.Plan(round, officials.Options{Roles: []string{"Referee"}, Officials: []officials.Official{{Official: ref}}})
+	if err != nil || len(assignments) != 0 {
+		t.Errorf("Planned %v, %v for games with their roles filled, expected nothing to do", assignments, err)
+	}
+}
+
+func TestConflicts(t *testing.T) {
+	c, round := newRound(t)
+	ref := c.CreateOfficial("Ref", nil)
+	ann := playerOfficial(c, "Ann")
+	referee := []string{"Referee"}
+
+	if _, err := officials.Plan(round, officials.Options{Roles: referee, Officials: []officials.Official{{Official: ann}}}); err == nil || !strings.Contains(err.Error(), "Ann vs Bob") {
+		t.Errorf("Planning with only an official who plays in the first game returned %v, expected the first game to have no official", err)
+	}
+	if _, err := officials.Plan(round, officials.Options{Roles: referee, Officials: []officials.Official{{Official: ref}}}); err == nil || !strings.Contains(err.Error(), "Cal vs Dee") {
+		t.Errorf("Planning with one official for two games at once returned %v, expected the second game to have no official", err)
+	}
+	if _, err := officials.Plan(round, officials.Options{Roles: []string{"Referee", "Scorekeeper"}, Officials: []officials.Official{{Official: ref}}}); err == nil || !strings.Contains(err.Error(), "Scorekeeper") {
+		t.Errorf("Planning with one official for two roles of a game returned %v, expected the second role to have no official", err)
+	}
+
+	// Once the games are booked at different times, one official can do both
+	court := c.GetArenas()[0]
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	games := round.GetGames()
+	games[0].Schedule(court, start, start.Add(time.Hour))
+	games[1].Schedule(court, start.Add(30*time.Minute), start.Add(90*time.Minute))
+	if _, err := officials.Plan(round, officials.Options{Roles: referee, Officials: []officials.Official{{Official: ref}}}); err == nil {
+		t.Errorf("Official was booked for overlapping games")
+	}
+	games[1].Schedule(court, start.Add(time.Hour), start.Add(2*time.Hour))
+	if assignments, err := officials.Plan(round, officials.Options{Roles: referee, Officials: []officials.Official{{Official: ref}}}); err != nil || len(assignments) != 2 {
+		t.Errorf("Planning one official for games one after the other returned %v, %v, expected them to do both", assignments, err)
+	}
+
+	// Or only one of them, if they are only around for one
+	away := officials.Official{Official: ref, Windows: []schedule.Window{{Start: start, End: start.Add(time.Hour)}}}
+	if _, err := officials.Plan(round, officials.Options{Roles: referee, Officials: []officials.Official{away}}); err == nil || !strings.Contains(err.Error(), "Cal vs Dee") {
+		t.Errorf("Planning with an official who leaves after the first game returned %v, expected the second game to have no official", err)
+	}
+}
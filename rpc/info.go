@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+)
+
+func newCompetitionInfo(c models.Competition) *pb.CompetitionInfo {
+	info := &pb.CompetitionInfo{Name: c.GetName()}
+	for _, t := range c.GetAllTournaments() {
+		info.Tournaments = append(info.Tournaments, t.GetName())
+	}
+	for _, a := range c.GetArenas() {
+		info.Arenas = append(info.Arenas, a.GetName())
+	}
+	return info
+}
+
+func newTournamentInfo(t models.Tournament) *pb.TournamentInfo {
+	info := &pb.TournamentInfo{
+		Name:      t.GetName(),
+		Type:      pb.TournamentType(t.GetType()),
+		Status:    pb.Status(t.GetStatus()),
+		GameSize:  t.GetGameSize(),
+		Advancing: t.GetAdvancing(),
+		Seeded:    t.IsSeeded(),
+		Scored:    t.IsScored(),
+		Brackets:  t.GetBracketOrder(),
+		Rounds:    uint32(len(t.GetAllRounds())),
+	}
+	for _, team := range t.GetTeams() {
+		info.Teams = append(info.Teams, team.GetName())
+	}
+	return info
+}
+
+func newRoundInfo(number int, r models.Round) *pb.RoundInfo {
+	info := &pb.RoundInfo{Number: uint32(number), Status: pb.Status(r.GetStatus())}
+	for i, g := range r.GetGames() {
+		info.Games = append(info.Games, newGameInfo(number, i+1, g))
+	}
+	return info
+}
+
+func newGameInfo(round int, number int, g models.Game) *pb.GameInfo {
+	info := &pb.GameInfo{
+		Round:   uint32(round),
+		Number:  uint32(number),
+		Bracket: g.GetBracket(),
+		Status:  pb.Status(g.GetStatus()),
+		Scores:  g.GetScores(),
+		Places:  g.GetPlaces(),
+	}
+	for _, t := range g.GetTeams() {
+		info.Teams = append(info.Teams, t.GetName())
+	}
+	for _, r := range g.GetResults() {
+		info.Results = append(info.Results, pb.Result(r))
+	}
+	if a := g.GetArena(); a != nil {
+		info.Arena = a.GetName()
+	}
+	return info
+}
+
+func newBracket(t models.Tournament) *pb.Bracket {
+	b := &pb.Bracket{Tournament: newTournamentInfo(t)}
+	for i, r := range t.GetAllRounds() {
+		b.Rounds = append(b.Rounds, newRoundInfo(i+1, r))
+	}
+	return b
+}
@@ -0,0 +1,259 @@
+// Package rpc serves competitions from any models.StorageEngine over gRPC, using the CompetitionService from models/storm/pb/service.proto.
+//
+// Competitions, tournaments and teams are addressed by name. Rounds and games are addressed by their number, starting at 1, in the order they were created
+package rpc
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/models/storm/pb"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// Server implements pb.CompetitionServiceServer for a StorageEngine
+type Server struct {
+	engine models.StorageEngine
+	mu     sync.Mutex // Changes are made one call at a time
+}
+
+// NewServer creates a Server for the competitions stored in engine
+func NewServer(engine models.StorageEngine) *Server {
+	return &Server{engine: engine}
+}
+
+// Register adds the CompetitionService to a gRPC server
+func (s *Server) Register(g *grpc.Server) {
+	pb.RegisterCompetitionServiceServer(g, s)
+}
+
+func (s *Server) competition(name string) (models.Competition, error) {
+	for _, c := range s.engine.GetCompetitions() {
+		if c.GetName() == name {
+			return c, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "No competition named %q", name)
+}
+
+// tournament finds a tournament, wrapped in its format so rounds can be created
+func (s *Server) tournament(ref *pb.TournamentRef) (models.Tournament, error) {
+	c, err := s.competition(ref.GetCompetition())
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range c.GetAllTournaments() {
+		if t.GetName() != ref.GetTournament() {
+			continue
+		}
+		wrapped, err := tournament.Wrap(t)
+		if err != nil {
+			return t, nil // Can still be viewed, but not advanced
+		}
+		return wrapped, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "No tournament named %q", ref.GetTournament())
+}
+
+func (s *Server) game(ref *pb.GameRef) (models.Tournament, models.Game, error) {
+	t, err := s.tournament(&pb.TournamentRef{Competition: ref.GetCompetition(), Tournament: ref.GetTournament()})
+	if err != nil {
+		return nil, nil, err
+	}
+	rounds := t.GetAllRounds()
+	if ref.GetRound() < 1 || int(ref.GetRound()) > len(rounds) {
+		return nil, nil, status.Errorf(codes.NotFound, "No round %d", ref.GetRound())
+	}
+	games := rounds[ref.GetRound()-1].GetGames()
+	if ref.GetGame() < 1 || int(ref.GetGame()) > len(games) {
+		return nil, nil, status.Errorf(codes.NotFound, "No game %d", ref.GetGame())
+	}
+	return t, games[ref.GetGame()-1], nil
+}
+
+// player finds a player by name, creating them if they don't exist yet
+func (s *Server) player(name string) models.Player {
+	for _, p := range s.engine.GetPlayers() {
+		if p.GetName() == name {
+			return p
+		}
+	}
+	return s.engine.CreatePlayer(name, nil)
+}
+
+func (s *Server) CreateCompetition(ctx context.Context, req *pb.CreateCompetitionRequest) (*pb.CompetitionInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A competition needs a name")
+	}
+	if _, err := s.competition(req.GetName()); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "There is already a competition named %q", req.GetName())
+	}
+	c := s.engine.CreateCompetition(req.GetName(), nil)
+	return newCompetitionInfo(c), nil
+}
+
+func (s *Server) AddTournament(ctx context.Context, req *pb.AddTournamentRequest) (*pb.TournamentInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, err := s.competition(req.GetCompetition())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A tournament needs a name")
+	}
+	for _, t := range c.GetAllTournaments() {
+		if t.GetName() == req.GetName() {
+			return nil, status.Errorf(codes.AlreadyExists, "There is already a tournament named %q", req.GetName())
+		}
+	}
+	gameSize, advancing := req.GetGameSize(), req.GetAdvancing()
+	if gameSize == 0 {
+		gameSize = 2
+	}
+	if advancing == 0 {
+		advancing = 1
+	}
+
+	t := c.AddTournament(req.GetName(), models.TournamentType(req.GetType()), nil, req.GetSeeded(), gameSize, advancing, req.GetScored())
+	for _, entry := range req.GetTeams() {
+		players := []models.Player{}
+		for _, name := range entry.GetPlayers() {
+			players = append(players, s.player(name))
+		}
+		t.CreateTeam(entry.GetName(), players, nil)
+	}
+	if wrapped, err := tournament.Wrap(t); err == nil {
+		t = wrapped
+	}
+	return newTournamentInfo(t), nil
+}
+
+// NextRound advances a tournament, creating its next round from the results of the last one. The last round is finished first, once all of its games are final
+func (s *Server) NextRound(ctx context.Context, req *pb.TournamentRef) (*pb.RoundInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, err := s.tournament(req)
+	if err != nil {
+		return nil, err
+	}
+	if rounds := t.GetAllRounds(); len(rounds) > 0 {
+		current := rounds[len(rounds)-1]
+		if current.GetStatus() != models.Status_COMPLETED {
+			for i, g := range current.GetGames() {
+				if g.GetStatus() != models.Status_COMPLETED {
+					return nil, status.Errorf(codes.FailedPrecondition, "Game %d of round %d isn't final yet", i+1, len(rounds))
+				}
+			}
+			current.SetFinal()
+		}
+	}
+	round, err := t.NextRound()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return newRoundInfo(len(t.GetAllRounds()), round), nil
+}
+
+// SubmitResult sets the scores and places of a game, and can finish it. Games that are already final can only be changed by an official, as a correction
+func (s *Server) SubmitResult(ctx context.Context, req *pb.SubmitResultRequest) (*pb.GameInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ref := req.GetGame()
+	t, game, err := s.game(ref)
+	if err != nil {
+		return nil, err
+	}
+	teams := len(game.GetTeams())
+	if len(req.GetScores()) != 0 && len(req.GetScores()) != teams {
+		return nil, status.Errorf(codes.InvalidArgument, "Expected %d scores, got %d", teams, len(req.GetScores()))
+	}
+	if len(req.GetPlaces()) != 0 && len(req.GetPlaces()) != teams {
+		return nil, status.Errorf(codes.InvalidArgument, "Expected %d places, got %d", teams, len(req.GetPlaces()))
+	}
+
+	if game.GetStatus() == models.Status_COMPLETED {
+		if req.GetOfficial() == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "Game is already final, an official has to correct it")
+		}
+		_, err = tournament.CorrectGame(t, game, req.GetOfficial(), req.GetScores(), req.GetPlaces(), req.GetReplay())
+		if err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		_, game, err = s.game(ref) // Later rounds may have been created again
+		if err != nil {
+			return nil, err
+		}
+		return newGameInfo(int(ref.GetRound()), int(ref.GetGame()), game), nil
+	}
+
+	if len(req.GetScores()) != 0 {
+		game.SetScores(req.GetScores())
+	}
+	if len(req.GetPlaces()) != 0 {
+		game.SetPlaces(req.GetPlaces())
+	}
+	if req.GetFinal() {
+		game.SetFinal()
+	}
+	return newGameInfo(int(ref.GetRound()), int(ref.GetGame()), game), nil
+}
+
+func (s *Server) GetBracket(ctx context.Context, req *pb.TournamentRef) (*pb.Bracket, error) {
+	t, err := s.tournament(req)
+	if err != nil {
+		return nil, err
+	}
+	return newBracket(t), nil
+}
+
+func (s *Server) WatchGame(req *pb.GameRef, stream pb.CompetitionService_WatchGameServer) error {
+	_, game, err := s.game(req)
+	if err != nil {
+		return err
+	}
+	round, number := int(req.GetRound()), int(req.GetGame())
+
+	// Games are read as the change is made. A nil game means it was removed
+	changes := make(chan *pb.GameInfo, 16)
+	cancel := s.engine.Subscribe(func(change models.Change) {
+		if change.Competition != req.GetCompetition() || change.Tournament != req.GetTournament() || change.Round != round || change.GameNumber != number {
+			return
+		}
+		var info *pb.GameInfo
+		if change.Game != nil {
+			info = newGameInfo(round, number, change.Game)
+		}
+		select {
+		case changes <- info:
+		default:
+		}
+	})
+	defer cancel()
+
+	err = stream.Send(newGameInfo(round, number, game))
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case info := <-changes:
+			if info == nil {
+				return status.Errorf(codes.NotFound, "Game was removed")
+			}
+			err = stream.Send(info)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
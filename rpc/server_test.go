@@ -0,0 +1,109 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models/storm/pb"
+)
+
+// newTestClient serves the CompetitionService for a fresh engine in memory, and returns a client connected to it
+func newTestClient(t *testing.T) pb.CompetitionServiceClient {
+	listener := bufconn.Listen(1 << 20)
+	g := grpc.NewServer()
+	NewServer(testutil.NewEngine(t)).Register(g)
+	go g.Serve(listener)
+	t.Cleanup(g.Stop)
+
+	dial := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dial), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewCompetitionServiceClient(conn)
+}
+
+func TestCompetitionService(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	c, err := client.CreateCompetition(ctx, &pb.CreateCompetitionRequest{Name: "Spring"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.GetName() != "Spring" {
+		t.Errorf("Created competition %q, expected Spring", c.GetName())
+	}
+	if _, err := client.CreateCompetition(ctx, &pb.CreateCompetitionRequest{Name: "Spring"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Creating a competition twice returned %v, expected %v", err, codes.AlreadyExists)
+	}
+
+	var teams []*pb.TeamEntry
+	for _, name := range []string{"Ann", "Bob", "Cal", "Dee"} {
+		teams = append(teams, &pb.TeamEntry{Name: name, Players: []string{name}})
+	}
+	info, err := client.AddTournament(ctx, &pb.AddTournamentRequest{Competition: "Spring", Name: "Open", Type: pb.TournamentType_SINGLE_ELIMINATION, Scored: true, Teams: teams})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.GetTeams()) != 4 || info.GetGameSize() != 2 || info.GetAdvancing() != 1 {
+		t.Errorf("Tournament is %+v, expected 4 teams in games of 2 with 1 advancing", info)
+	}
+
+	open := &pb.TournamentRef{Competition: "Spring", Tournament: "Open"}
+	round, err := client.NextRound(ctx, open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.GetNumber() != 1 || len(round.GetGames()) != 2 {
+		t.Fatalf("Round is %+v, expected round 1 with 2 games", round)
+	}
+	if _, err := client.NextRound(ctx, open); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Creating a round before the last one is over returned %v, expected %v", err, codes.FailedPrecondition)
+	}
+
+	first := &pb.GameRef{Competition: "Spring", Tournament: "Open", Round: 1, Game: 1}
+	game, err := client.SubmitResult(ctx, &pb.SubmitResultRequest{Game: first, Scores: []int64{3, 1}, Final: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if game.GetStatus() != pb.Status_COMPLETED || game.GetResults()[0] != pb.Result_WIN || game.GetResults()[1] != pb.Result_LOSS {
+		t.Errorf("Finished game is %+v, expected the first team to have won", game)
+	}
+	if _, err := client.SubmitResult(ctx, &pb.SubmitResultRequest{Game: first, Scores: []int64{1, 3}}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Changing a final game without an official returned %v, expected %v", err, codes.FailedPrecondition)
+	}
+	second := &pb.GameRef{Competition: "Spring", Tournament: "Open", Round: 1, Game: 2}
+	if _, err := client.SubmitResult(ctx, &pb.SubmitResultRequest{Game: second, Scores: []int64{1}}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Setting too few scores returned %v, expected %v", err, codes.InvalidArgument)
+	}
+	if _, err := client.SubmitResult(ctx, &pb.SubmitResultRequest{Game: second, Scores: []int64{0, 2}, Final: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	round, err = client.NextRound(ctx, open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.GetNumber() != 2 || len(round.GetGames()) != 1 {
+		t.Fatalf("Round is %+v, expected round 2 with the final", round)
+	}
+	if final := round.GetGames()[0].GetTeams(); len(final) != 2 || final[0] != "Ann" && final[1] != "Ann" {
+		t.Errorf("Final is between %v, expected Ann to have advanced", final)
+	}
+
+	missing := &pb.GameRef{Competition: "Spring", Tournament: "Open", Round: 3, Game: 1}
+	if _, err := client.SubmitResult(ctx, &pb.SubmitResultRequest{Game: missing, Final: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("Submitting the result of a missing round returned %v, expected %v", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,369 @@
+// Package schedule books the games of a round into arenas and time slots.
+//
+// Games are booked in the order of the round, each into the earliest slot where its arena is free and none of its teams or players are already playing.
+// Games already booked into the arenas, and other games the teams and players are booked for, are worked around
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// Window is a period of time when an arena can be used
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Arena is an arena with the windows when it is available
+type Arena struct {
+	Arena   models.Arena
+	Windows []Window
+}
+
+// Options describe when and where games can be played
+type Options struct {
+	Start    time.Time     // No game is booked to start before this
+	Duration time.Duration // How long each game is booked for
+	Arenas   []Arena       // Arenas to play in. When several are free at the same time, earlier arenas are used first
+	Rest     time.Duration // Least time a team or player has between the end of one game and the start of their next
+
+	// Competition the round is in. Players can be on teams in several of its tournaments, and aren't booked into two games at once across them.
+	// If nil, every game a player has been booked for is checked
+	Competition models.Competition
+}
+
+// ConflictError explains why a game couldn't be booked
+type ConflictError struct {
+	Game    models.Game
+	Reasons []string // What stops the game being booked into each of the times it could otherwise be played
+}
+
+func (e *ConflictError) Error() string {
+	if len(e.Reasons) == 0 {
+		return fmt.Sprintf("No time slot left in any arena for %s", describe(e.Game))
+	}
+	return fmt.Sprintf("No time slot left in any arena for %s: %s", describe(e.Game), strings.Join(e.Reasons, "; "))
+}
+
+// interval is a booked period of time
+type interval struct {
+	start time.Time
+	end   time.Time
+}
+
+func (i interval) overlaps(other interval) bool {
+	return i.start.Before(other.end) && other.start.Before(i.end)
+}
+
+// commitment is a game a team or player is booked for
+type commitment struct {
+	interval
+	who  string // Team, or player
+	game string
+}
+
+// blocks reports whether a slot overlaps the commitment, or leaves less than rest between them
+func (c commitment) blocks(slot interval, rest time.Duration) bool {
+	return slot.start.Before(c.end.Add(rest)) && c.start.Before(slot.end.Add(rest))
+}
+
+// reason explains how the commitment blocks a slot
+func (c commitment) reason(slot interval) string {
+	if slot.overlaps(c.interval) {
+		return fmt.Sprintf("%s is playing %s from %s to %s", c.who, c.game, c.start.Format(timeFormat), c.end.Format(timeFormat))
+	}
+	if c.start.Before(slot.start) {
+		return fmt.Sprintf("%s needs rest after playing %s, which ends at %s", c.who, c.game, c.end.Format(timeFormat))
+	}
+	return fmt.Sprintf("%s needs rest before playing %s, which starts at %s", c.who, c.game, c.start.Format(timeFormat))
+}
+
+const timeFormat = "Jan 2 15:04"
+
+// slotKey identifies a game by its teams and booked slot, so games can be matched however they were loaded
+type slotKey struct {
+	interval
+	teams string
+}
+
+// Round books every game of the round that still needs to be played into an arena and a time slot
+func Round(round models.Round, opts Options) ([]models.Booking, error) {
+	bookings, err := Plan(round, opts)
+	if err != nil {
+		return nil, err
+	}
+	round.Schedule(bookings)
+	return bookings, nil
+}
+
+// Plan works out the bookings for the games of a round without making them. Games that are over, and byes, aren't booked.
+// When a game can't be booked the error is a *ConflictError explaining why
+func Plan(round models.Round, opts Options) ([]models.Booking, error) {
+	if opts.Duration <= 0 {
+		return nil, fmt.Errorf("Games need a duration to be scheduled")
+	}
+	if len(opts.Arenas) == 0 {
+		return nil, fmt.Errorf("No arenas to schedule games in")
+	}
+	if opts.Rest < 0 {
+		return nil, fmt.Errorf("Rest between games can't be negative")
+	}
+
+	var games []models.Game
+	own := map[slotKey]bool{} // Slots the round's games already have are being replaced, so they don't count as taken
+	for _, g := range round.GetGames() {
+		if g.GetStatus() == models.Status_COMPLETED || len(playingTeams(g)) < 2 {
+			continue
+		}
+		games = append(games, g)
+		if slot, ok := booked(g); ok {
+			own[slotKey{slot, describe(g)}] = true
+		}
+	}
+	taken := func(g models.Game) (interval, bool) {
+		slot, ok := booked(g)
+		return slot, ok && !own[slotKey{slot, describe(g)}]
+	}
+
+	arenaBusy := map[string][]interval{}
+	for _, a := range opts.Arenas {
+		for _, g := range a.Arena.GetGames() {
+			if slot, ok := taken(g); ok {
+				arenaBusy[a.Arena.GetName()] = append(arenaBusy[a.Arena.GetName()], slot)
+			}
+		}
+	}
+
+	teamBusy := map[uint64][]commitment{} // By team id, as teams in different tournaments can share a name
+	playerBusy := map[string][]commitment{}
+	players := map[string]bool{}
+	for _, g := range games {
+		for _, t := range playingTeams(g) {
+			for _, other := range t.GetRecords() {
+				if slot, ok := taken(other); ok {
+					teamBusy[t.GetId()] = append(teamBusy[t.GetId()], commitment{slot, t.GetName(), describe(other)})
+				}
+			}
+			for _, p := range t.GetPlayers() {
+				players[p.GetName()] = true
+			}
+		}
+	}
+	for _, other := range playerGames(opts, games) {
+		slot, ok := taken(other)
+		if !ok {
+			continue
+		}
+		for _, t := range playingTeams(other) {
+			for _, p := range t.GetPlayers() {
+				if players[p.GetName()] {
+					playerBusy[p.GetName()] = append(playerBusy[p.GetName()], commitment{slot, playerLabel(p, t), describe(other)})
+				}
+			}
+		}
+	}
+
+	var bookings []models.Booking
+	for _, g := range games {
+		var busy []commitment
+		for _, t := range playingTeams(g) {
+			busy = append(busy, teamBusy[t.GetId()]...)
+			for _, p := range t.GetPlayers() {
+				for _, c := range playerBusy[p.GetName()] {
+					if !sameGame(c, teamBusy[t.GetId()]) { // Games of the player's team already count for the team
+						busy = append(busy, c)
+					}
+				}
+			}
+		}
+
+		best := -1
+		var bestStart time.Time
+		for i, a := range opts.Arenas {
+			start, ok := earliest(a.Windows, opts, arenaBusy[a.Arena.GetName()], busy)
+			if ok && (best < 0 || start.Before(bestStart)) {
+				best, bestStart = i, start
+			}
+		}
+		if best < 0 {
+			return nil, explain(g, opts, arenaBusy, busy)
+		}
+
+		arena := opts.Arenas[best].Arena
+		slot := interval{bestStart, bestStart.Add(opts.Duration)}
+		arenaBusy[arena.GetName()] = append(arenaBusy[arena.GetName()], slot)
+		for _, t := range playingTeams(g) {
+			teamBusy[t.GetId()] = append(teamBusy[t.GetId()], commitment{slot, t.GetName(), describe(g)})
+			for _, p := range t.GetPlayers() {
+				playerBusy[p.GetName()] = append(playerBusy[p.GetName()], commitment{slot, playerLabel(p, t), describe(g)})
+			}
+		}
+		bookings = append(bookings, models.Booking{Game: g, Arena: arena, Start: slot.start, End: slot.end})
+	}
+	return bookings, nil
+}
+
+// playerGames returns the games to check the players of the round's games against
+func playerGames(opts Options, games []models.Game) []models.Game {
+	var found []models.Game
+	if opts.Competition != nil {
+		for _, t := range opts.Competition.GetAllTournaments() {
+			for _, r := range t.GetAllRounds() {
+				found = append(found, r.GetGames()...)
+			}
+		}
+		return found
+	}
+	seen := map[string]bool{}
+	for _, g := range games {
+		for _, t := range playingTeams(g) {
+			for _, p := range t.GetPlayers() {
+				if !seen[p.GetName()] {
+					seen[p.GetName()] = true
+					found = append(found, p.GetRecords()...)
+				}
+			}
+		}
+	}
+	return found
+}
+
+func playerLabel(p models.Player, t models.Team) string {
+	return fmt.Sprintf("%s (of %s)", p.GetName(), t.GetName())
+}
+
+// sameGame reports whether a player's commitment is a game their team is already committed to
+func sameGame(c commitment, team []commitment) bool {
+	for _, t := range team {
+		if t.interval == c.interval && t.game == c.game {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates are the times a game could start: the start of a window, or when something booked in the arena ends, or when a team or player is rested after a game
+func candidates(windows []Window, opts Options, arenaBusy []interval, busy []commitment) []time.Time {
+	var times []time.Time
+	for _, w := range windows {
+		times = append(times, w.Start)
+	}
+	for _, b := range arenaBusy {
+		times = append(times, b.end)
+	}
+	for _, c := range busy {
+		times = append(times, c.end.Add(opts.Rest))
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	for i := range times {
+		if times[i].Before(opts.Start) {
+			times[i] = opts.Start
+		}
+	}
+	return times
+}
+
+// arenaFree reports whether a slot is inside one of the arena's windows and nothing else is booked in the arena then
+func arenaFree(slot interval, windows []Window, arenaBusy []interval) bool {
+	if !fits(slot, windows) {
+		return false
+	}
+	for _, b := range arenaBusy {
+		if slot.overlaps(b) {
+			return false
+		}
+	}
+	return true
+}
+
+// earliest finds the first time a game fits into an arena without clashing with anything its teams or players are booked for
+func earliest(windows []Window, opts Options, arenaBusy []interval, busy []commitment) (time.Time, bool) {
+	for _, c := range candidates(windows, opts, arenaBusy, busy) {
+		slot := interval{c, c.Add(opts.Duration)}
+		if !arenaFree(slot, windows, arenaBusy) {
+			continue
+		}
+		free := true
+		for _, b := range busy {
+			if b.blocks(slot, opts.Rest) {
+				free = false
+				break
+			}
+		}
+		if free {
+			return c, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// explain works out why a game couldn't be booked, from what clashes with it at each time an arena is free
+func explain(g models.Game, opts Options, arenaBusy map[string][]interval, busy []commitment) error {
+	err := &ConflictError{Game: g}
+	seen := map[string]bool{}
+	for _, a := range opts.Arenas {
+		free := false
+		for _, c := range candidates(a.Windows, opts, arenaBusy[a.Arena.GetName()], busy) {
+			slot := interval{c, c.Add(opts.Duration)}
+			if !arenaFree(slot, a.Windows, arenaBusy[a.Arena.GetName()]) {
+				continue
+			}
+			free = true
+			for _, b := range busy {
+				if b.blocks(slot, opts.Rest) {
+					if reason := b.reason(slot); !seen[reason] {
+						seen[reason] = true
+						err.Reasons = append(err.Reasons, reason)
+					}
+				}
+			}
+		}
+		if !free {
+			err.Reasons = append(err.Reasons, fmt.Sprintf("%s has no %s free in its windows around the games already booked", a.Arena.GetName(), opts.Duration))
+		}
+	}
+	return err
+}
+
+// fits reports whether a slot is entirely inside one of the windows
+func fits(slot interval, windows []Window) bool {
+	for _, w := range windows {
+		if !slot.start.Before(w.Start) && !slot.end.After(w.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// booked returns the slot a game is booked for, if it has been scheduled
+func booked(g models.Game) (interval, bool) {
+	start, end := g.GetSchedule()
+	if start.IsZero() {
+		return interval{}, false
+	}
+	return interval{start, end}, true
+}
+
+// playingTeams returns the teams of a game, leaving out byes
+func playingTeams(g models.Game) []models.Team {
+	var teams []models.Team
+	for _, t := range g.GetTeams() {
+		if !models.IsByeTeam(t) {
+			teams = append(teams, t)
+		}
+	}
+	return teams
+}
+
+func describe(g models.Game) string {
+	var names []string
+	for _, t := range g.GetTeams() {
+		names = append(names, t.GetName())
+	}
+	return strings.Join(names, " vs ")
+}
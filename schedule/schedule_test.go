@@ -0,0 +1,54 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/schedule"
+	"github.com/justinjudd/competition/tournament"
+)
+
+func TestTeamsSharingAName(t *testing.T) {
+	e := testutil.NewEngine(t)
+
+	// Each group has a team called Ants, and the groups play their rounds at the same time
+	c := e.CreateCompetition("League", nil)
+	var groups []models.Tournament
+	for _, group := range [][]string{{"A", "Ants", "Ann", "Bees", "Bob"}, {"B", "Ants", "Amy", "Cats", "Cal"}} {
+		base := c.AddTournament("Group "+group[0], models.TournamentType_ROUND_ROBIN, nil, false, 2, 1, false)
+		for i := 1; i < len(group); i += 2 {
+			base.CreateTeam(group[i], []models.Player{e.CreatePlayer(group[i+1], nil)}, nil)
+		}
+		wrapped, err := tournament.Wrap(base)
+		if err != nil {
+			t.Fatal(err)
+		}
+		groups = append(groups, wrapped)
+	}
+	base := c.AddTournament("Groups", models.TournamentType_GROUP_PLAY, nil, false, 2, 1, false)
+	round, err := tournament.NewGroupCompetition(groups, base).NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	window := []schedule.Window{{Start: start, End: start.Add(3 * time.Hour)}}
+	bookings, err := schedule.Plan(round, schedule.Options{
+		Start:    start,
+		Duration: time.Hour,
+		Arenas:   []schedule.Arena{{Arena: c.CreateArena("Court 1"), Windows: window}, {Arena: c.CreateArena("Court 2"), Windows: window}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bookings) != 2 {
+		t.Fatalf("Booked %d games, expected 2", len(bookings))
+	}
+	for _, b := range bookings {
+		if !b.Start.Equal(start) {
+			t.Errorf("Game booked at %s, expected both games at %s", b.Start, start)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/justinjudd/competition"
+)
+
+// Calendars are served at stable URLs, so calendar apps can subscribe to them and pick up changes to the schedule
+
+func (s *Server) competitionCalendar(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	cal, err := competition.CompetitionCalendar(c)
+	writeCalendar(w, cal, err)
+}
+
+func (s *Server) teamCalendar(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	_, team, err := s.team(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	cal, err := competition.TeamCalendar(c, team)
+	writeCalendar(w, cal, err)
+}
+
+func (s *Server) playerCalendar(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	name := mux.Vars(r)["player"]
+	p := s.player(name)
+	if p == nil {
+		writeError(w, notFound("No player named %q", name))
+		return
+	}
+	cal, err := competition.PlayerCalendar(c, p)
+	writeCalendar(w, cal, err)
+}
+
+func (s *Server) arenaCalendar(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	a, err := s.arena(r, mux.Vars(r)["arena"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	cal, err := competition.ArenaCalendar(c, a)
+	writeCalendar(w, cal, err)
+}
+
+func writeCalendar(w http.ResponseWriter, cal []byte, err error) {
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Write(cal)
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/justinjudd/competition"
+)
+
+// Players and teams are imported from CSV in the request body. A file with problems imports nothing, and every problem is reported by row
+
+// rowErrorView is a problem with a row of an imported file
+type rowErrorView struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// writeImportError reports the problems with an imported file, one for each row
+func writeImportError(w http.ResponseWriter, err error) {
+	var problems competition.ImportErrors
+	if !errors.As(err, &problems) {
+		writeError(w, err)
+		return
+	}
+	rows := make([]rowErrorView, len(problems))
+	for i, p := range problems {
+		rows[i] = rowErrorView{Row: p.Row, Error: p.Err.Error()}
+	}
+	writeJSON(w, http.StatusBadRequest, map[string]interface{}{"error": "Invalid CSV", "rows": rows})
+}
+
+func (s *Server) importPlayers(w http.ResponseWriter, r *http.Request) {
+	players, err := competition.ImportPlayers(s.engine, r.Body)
+	if err != nil {
+		writeImportError(w, err)
+		return
+	}
+	views := []playerView{}
+	for _, p := range players {
+		views = append(views, playerView{Name: p.GetName()})
+	}
+	writeJSON(w, http.StatusCreated, views)
+}
+
+func (s *Server) importTeams(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	teams, err := competition.ImportTeams(s.engine, t, r.Body)
+	if err != nil {
+		writeImportError(w, err)
+		return
+	}
+	views := []teamView{}
+	for _, team := range teams {
+		views = append(views, newTeamView(team))
+	}
+	writeJSON(w, http.StatusCreated, views)
+}
+
+func (s *Server) exportResults(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	data, err := competition.ExportResults(t)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Write(data)
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/justinjudd/competition/hosted"
+	"github.com/justinjudd/competition/models"
+)
+
+// Brackets are moved to and from hosting sites as the JSON the sites use, in the request or response body. The site is challonge or startgg
+
+// hostedFormat converts tournaments for a hosting site
+type hostedFormat struct {
+	export func(models.Tournament) ([]byte, error)
+	load   func(models.StorageEngine, models.Competition, []byte) (models.Tournament, error)
+}
+
+var hostedFormats = map[string]hostedFormat{
+	"challonge": {hosted.ExportChallonge, hosted.ImportChallonge},
+	"startgg":   {hosted.ExportStartGG, hosted.ImportStartGG},
+}
+
+// hostedSite finds the hosting site for the request
+func hostedSite(r *http.Request) (hostedFormat, error) {
+	name := mux.Vars(r)["site"]
+	format, ok := hostedFormats[name]
+	if !ok {
+		return format, notFound("No bracket site named %q", name)
+	}
+	return format, nil
+}
+
+func (s *Server) exportHosted(w http.ResponseWriter, r *http.Request) {
+	format, err := hostedSite(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	data, err := format.export(t)
+	if err != nil {
+		writeError(w, badRequest("%v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func (s *Server) importHosted(w http.ResponseWriter, r *http.Request) {
+	format, err := hostedSite(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, badRequest("Invalid request body: %v", err))
+		return
+	}
+	t, err := format.load(s.engine, c, data)
+	if err != nil {
+		writeError(w, badRequest("%v", err))
+		return
+	}
+	writeJSON(w, http.StatusCreated, newTournamentView(t))
+}
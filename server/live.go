@@ -0,0 +1,237 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/justinjudd/competition"
+	"github.com/justinjudd/competition/models"
+)
+
+// heartbeat is how often a comment is sent on quiet event streams, so proxies don't close them
+const heartbeat = 15 * time.Second
+
+type changeView struct {
+	Competition string    `json:"competition"`
+	Tournament  string    `json:"tournament"`
+	Round       int       `json:"round"`
+	Game        int       `json:"game"`
+	Fields      []string  `json:"fields"`
+	State       *gameView `json:"state,omitempty"` // Missing if the game was removed
+}
+
+func newChangeView(c models.Change) changeView {
+	v := changeView{
+		Competition: c.Competition,
+		Tournament:  c.Tournament,
+		Round:       c.Round,
+		Game:        c.GameNumber,
+		Fields:      c.Fields,
+	}
+	if c.Game != nil {
+		game := newGameView(c.GameNumber, c.Game)
+		v.State = &game
+	}
+	return v
+}
+
+// watch streams the changes to the games of a competition, or of one of its tournaments, as Server-Sent Events
+func (s *Server) watch(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	tournamentName, scoped := mux.Vars(r)["tournament"]
+	if scoped {
+		if _, err := s.tournament(r); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, fmt.Errorf("Streaming isn't supported"))
+		return
+	}
+
+	// Views are built as the change is made, and dropped if the spectator has fallen too far behind
+	changes := make(chan changeView, 64)
+	cancel := s.engine.Subscribe(func(change models.Change) {
+		if change.Competition != c.GetName() || (scoped && change.Tournament != tournamentName) {
+			return
+		}
+		select {
+		case changes <- newChangeView(change):
+		default:
+		}
+	})
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		case change := <-changes:
+			data, err := json.Marshal(change)
+			if err != nil {
+				log.Printf("Unable to encode change: %v", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: game\ndata: %s\n\n", data)
+		}
+		flusher.Flush()
+	}
+}
+
+// getBracket returns the HTML brackets of a tournament, for pages to show and /live.js to refresh
+func (s *Server) getBracket(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	page, err := competition.GenerateTournamentHTML(t)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(page)
+}
+
+// courtDisplay shows the game being played at an arena, or the next one ready to be played, for screens beside the court.
+// Arenas are found by the name GameToHTML links to them with, lower case without spaces
+func (s *Server) courtDisplay(w http.ResponseWriter, r *http.Request) {
+	slug := mux.Vars(r)["arena"]
+	var found models.Arena
+	for _, c := range s.engine.GetCompetitions() {
+		for _, a := range c.GetArenas() {
+			if strings.ToLower(strings.Replace(a.GetName(), " ", "", -1)) == slug {
+				found = a
+			}
+		}
+	}
+	if found == nil {
+		writeError(w, notFound("No arena named %q", slug))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	next := found.NextGame()
+	if next == nil {
+		fmt.Fprintf(w, "<div class=\"arena\"><b>%s</b><p>No games waiting</p></div>", html.EscapeString(found.GetName()))
+		return
+	}
+	page, err := competition.BigGameToHTML(next, next.IsScored())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Write(page)
+}
+
+func serveLiveScript(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/javascript")
+	fmt.Fprint(w, liveScript)
+}
+
+// liveScript keeps the brackets of a tournament up to date on a page. Any element with a data-live attribute set to the path of a tournament,
+// eg <div data-live="/competitions/Spring/tournaments/Open"></div>, is filled with its brackets, and each .bracket inside it is replaced in place
+// whenever one of its games changes. A "bracketchange" event with the change is dispatched on the element afterwards
+const liveScript = `(function () {
+  "use strict";
+
+  function Live(el) {
+    this.el = el;
+    this.base = el.getAttribute("data-live").replace(/\/$/, "");
+    this.loading = false;
+    this.again = false;
+  }
+
+  Live.prototype.refresh = function (change) {
+    var self = this;
+    if (self.loading) {
+      self.again = true;
+      return;
+    }
+    self.loading = true;
+    fetch(self.base + "/bracket").then(function (resp) {
+      if (!resp.ok) {
+        throw new Error(resp.status + " " + resp.statusText);
+      }
+      return resp.text();
+    }).then(function (html) {
+      self.update(html);
+      if (change) {
+        self.el.dispatchEvent(new CustomEvent("bracketchange", { detail: change }));
+      }
+    }).catch(function (err) {
+      console.error("Unable to refresh bracket:", err);
+    }).then(function () {
+      self.loading = false;
+      if (self.again) {
+        self.again = false;
+        self.refresh(change);
+      }
+    });
+  };
+
+  Live.prototype.update = function (html) {
+    var fresh = document.createElement("div");
+    fresh.innerHTML = html;
+    var current = this.el.querySelectorAll(".bracket");
+    var next = fresh.querySelectorAll(".bracket");
+    if (current.length !== next.length) {
+      this.el.innerHTML = html; // Brackets were added or removed
+      return;
+    }
+    for (var i = 0; i < next.length; i++) {
+      if (current[i].innerHTML !== next[i].innerHTML) {
+        current[i].innerHTML = next[i].innerHTML;
+      }
+    }
+  };
+
+  Live.prototype.watch = function () {
+    var self = this;
+    var source = new EventSource(self.base + "/events");
+    source.addEventListener("game", function (e) {
+      self.refresh(JSON.parse(e.data));
+    });
+    source.addEventListener("open", function () {
+      self.refresh(); // Catch up on anything missed while disconnected
+    });
+  };
+
+  function start() {
+    var els = document.querySelectorAll("[data-live]");
+    for (var i = 0; i < els.length; i++) {
+      new Live(els[i]).watch();
+    }
+  }
+
+  if (document.readyState === "loading") {
+    document.addEventListener("DOMContentLoaded", start);
+  } else {
+    start();
+  }
+})();
+`
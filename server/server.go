@@ -0,0 +1,873 @@
+// Package server exposes competitions from any models.StorageEngine as JSON REST resources.
+//
+// Spectators can follow games live: the events resources of competitions and tournaments stream changes as Server-Sent Events,
+// and /live.js keeps brackets on a page up to date with them.
+//
+// Competitions, tournaments, teams, players and arenas are addressed by name. Rounds and games are addressed by their number, starting at 1, in the order they were created
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gorilla/mux"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// Server handles the REST API for a StorageEngine
+type Server struct {
+	engine models.StorageEngine
+	router *mux.Router
+	mu     sync.Mutex // Changes are made one request at a time
+}
+
+// NewServer creates a Server for the competitions stored in engine
+func NewServer(engine models.StorageEngine) *Server {
+	s := &Server{engine: engine, router: mux.NewRouter()}
+
+	r := s.router
+	r.HandleFunc("/competitions", s.listCompetitions).Methods(http.MethodGet)
+	r.HandleFunc("/competitions", s.createCompetition).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}", s.getCompetition).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/undo", s.undo).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/redo", s.redo).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/events", s.watch).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/calendar.ics", s.competitionCalendar).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/players/{player}/calendar.ics", s.playerCalendar).Methods(http.MethodGet)
+
+	r.HandleFunc("/competitions/{competition}/arenas", s.listArenas).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/arenas", s.createArena).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/arenas/assign", s.assignArenas).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/arenas/{arena}", s.getArena).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/arenas/{arena}/calendar.ics", s.arenaCalendar).Methods(http.MethodGet)
+	r.HandleFunc("/arena/{arena}", s.courtDisplay).Methods(http.MethodGet)
+
+	r.HandleFunc("/competitions/{competition}/officials", s.listOfficials).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/officials", s.createOfficial).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/losers-ref", s.setLosersRef).Methods(http.MethodPut)
+
+	r.HandleFunc("/competitions/{competition}/tournaments", s.listTournaments).Methods(http.MethodGet)
+	r.HandleFunc("/competitions/{competition}/tournaments", s.createTournament).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/import/{site}", s.importHosted).Methods(http.MethodPost)
+	r.HandleFunc("/competitions/{competition}/trf", s.importTRF).Methods(http.MethodPost)
+	t := r.PathPrefix("/competitions/{competition}/tournaments/{tournament}").Subrouter()
+	t.HandleFunc("", s.getTournament).Methods(http.MethodGet)
+	t.HandleFunc("/standings", s.getStandings).Methods(http.MethodGet)
+	t.HandleFunc("/bracket", s.getBracket).Methods(http.MethodGet)
+	t.HandleFunc("/results.csv", s.exportResults).Methods(http.MethodGet)
+	t.HandleFunc("/export/{site}", s.exportHosted).Methods(http.MethodGet)
+	t.HandleFunc("/report.trf", s.exportTRF).Methods(http.MethodGet)
+	t.HandleFunc("/events", s.watch).Methods(http.MethodGet)
+	t.HandleFunc("/teams", s.listTeams).Methods(http.MethodGet)
+	t.HandleFunc("/teams", s.createTeam).Methods(http.MethodPost)
+	t.HandleFunc("/teams/import", s.importTeams).Methods(http.MethodPost)
+	t.HandleFunc("/teams/{team}", s.getTeam).Methods(http.MethodGet)
+	t.HandleFunc("/teams/{team}/withdraw", s.withdrawTeam).Methods(http.MethodPost)
+	t.HandleFunc("/teams/{team}/calendar.ics", s.teamCalendar).Methods(http.MethodGet)
+	t.HandleFunc("/teams/{team}/versus/{other}", s.teamHeadToHead).Methods(http.MethodGet)
+	t.HandleFunc("/rounds", s.listRounds).Methods(http.MethodGet)
+	t.HandleFunc("/rounds", s.nextRound).Methods(http.MethodPost)
+	t.HandleFunc("/rounds/{round}", s.getRound).Methods(http.MethodGet)
+	t.HandleFunc("/rounds/{round}/start", s.startRound).Methods(http.MethodPost)
+	t.HandleFunc("/rounds/{round}/final", s.finishRound).Methods(http.MethodPost)
+	t.HandleFunc("/rounds/{round}/games/{game}", s.getGame).Methods(http.MethodGet)
+	t.HandleFunc("/rounds/{round}/games/{game}/start", s.startGame).Methods(http.MethodPost)
+	t.HandleFunc("/rounds/{round}/games/{game}/scores", s.setScores).Methods(http.MethodPut)
+	t.HandleFunc("/rounds/{round}/games/{game}/places", s.setPlaces).Methods(http.MethodPut)
+	t.HandleFunc("/rounds/{round}/games/{game}/arena", s.setArena).Methods(http.MethodPut)
+	t.HandleFunc("/rounds/{round}/games/{game}/officials", s.assignOfficial).Methods(http.MethodPost)
+	t.HandleFunc("/rounds/{round}/games/{game}/final", s.finishGame).Methods(http.MethodPost)
+	t.HandleFunc("/rounds/{round}/games/{game}/corrections", s.correctGame).Methods(http.MethodPost)
+
+	r.HandleFunc("/players", s.listPlayers).Methods(http.MethodGet)
+	r.HandleFunc("/players", s.createPlayer).Methods(http.MethodPost)
+	r.HandleFunc("/players/import", s.importPlayers).Methods(http.MethodPost)
+	r.HandleFunc("/players/{player}", s.getPlayer).Methods(http.MethodGet)
+	r.HandleFunc("/players/{player}/stats", s.playerStats).Methods(http.MethodGet)
+	r.HandleFunc("/players/{player}/versus/{other}", s.playerHeadToHead).Methods(http.MethodGet)
+
+	r.HandleFunc("/live.js", serveLiveScript).Methods(http.MethodGet)
+
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+	s.router.ServeHTTP(w, r)
+}
+
+// Router returns the router for the API, so more routes can be added alongside it
+func (s *Server) Router() *mux.Router {
+	return s.router
+}
+
+// httpError is an error with the status code that should be sent for it
+type httpError struct {
+	status int
+	err    error
+}
+
+func (e *httpError) Error() string {
+	return e.err.Error()
+}
+
+func notFound(format string, a ...interface{}) error {
+	return &httpError{http.StatusNotFound, fmt.Errorf(format, a...)}
+}
+
+func badRequest(format string, a ...interface{}) error {
+	return &httpError{http.StatusBadRequest, fmt.Errorf(format, a...)}
+}
+
+func conflict(err error) error {
+	return &httpError{http.StatusConflict, err}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		log.Printf("Unable to write response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	var he *httpError
+	if errors.As(err, &he) {
+		status = he.status
+	}
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func readJSON(r *http.Request, v interface{}) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return badRequest("Invalid request body: %v", err)
+	}
+	return nil
+}
+
+func (s *Server) competition(r *http.Request) (models.Competition, error) {
+	name := mux.Vars(r)["competition"]
+	for _, c := range s.engine.GetCompetitions() {
+		if c.GetName() == name {
+			return c, nil
+		}
+	}
+	return nil, notFound("No competition named %q", name)
+}
+
+// tournament finds the tournament for the request, wrapped in its format so rounds can be created
+func (s *Server) tournament(r *http.Request) (models.Tournament, error) {
+	c, err := s.competition(r)
+	if err != nil {
+		return nil, err
+	}
+	name := mux.Vars(r)["tournament"]
+	for _, t := range c.GetAllTournaments() {
+		if t.GetName() != name {
+			continue
+		}
+		wrapped, err := tournament.Wrap(t)
+		if err != nil {
+			return t, nil // Can still be viewed, but not advanced
+		}
+		return wrapped, nil
+	}
+	return nil, notFound("No tournament named %q", name)
+}
+
+func (s *Server) round(r *http.Request) (models.Tournament, models.Round, int, error) {
+	t, err := s.tournament(r)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	rounds := t.GetAllRounds()
+	number, err := strconv.Atoi(mux.Vars(r)["round"])
+	if err != nil || number < 1 || number > len(rounds) {
+		return nil, nil, 0, notFound("No round %s", mux.Vars(r)["round"])
+	}
+	return t, rounds[number-1], number, nil
+}
+
+func (s *Server) game(r *http.Request) (models.Tournament, models.Game, int, error) {
+	t, round, _, err := s.round(r)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	games := round.GetGames()
+	number, err := strconv.Atoi(mux.Vars(r)["game"])
+	if err != nil || number < 1 || number > len(games) {
+		return nil, nil, 0, notFound("No game %s", mux.Vars(r)["game"])
+	}
+	return t, games[number-1], number, nil
+}
+
+func (s *Server) team(r *http.Request) (models.Tournament, models.Team, error) {
+	t, err := s.tournament(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	name := mux.Vars(r)["team"]
+	for _, team := range t.GetTeams() {
+		if team.GetName() == name {
+			return t, team, nil
+		}
+	}
+	return nil, nil, notFound("No team named %q", name)
+}
+
+// player finds a player by name, nil if there isn't one
+func (s *Server) player(name string) models.Player {
+	for _, p := range s.engine.GetPlayers() {
+		if p.GetName() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) arena(r *http.Request, name string) (models.Arena, error) {
+	c, err := s.competition(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range c.GetArenas() {
+		if a.GetName() == name {
+			return a, nil
+		}
+	}
+	return nil, notFound("No arena named %q", name)
+}
+
+func (s *Server) official(r *http.Request, name string) (models.Official, error) {
+	c, err := s.competition(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range c.GetOfficials() {
+		if o.GetName() == name {
+			return o, nil
+		}
+	}
+	return nil, notFound("No official named %q", name)
+}
+
+func (s *Server) listCompetitions(w http.ResponseWriter, r *http.Request) {
+	views := []competitionView{}
+	for _, c := range s.engine.GetCompetitions() {
+		views = append(views, newCompetitionView(c))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) createCompetition(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("A competition needs a name"))
+		return
+	}
+	c := s.engine.CreateCompetition(req.Name, nil)
+	writeJSON(w, http.StatusCreated, newCompetitionView(c))
+}
+
+func (s *Server) getCompetition(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newCompetitionView(c))
+}
+
+func (s *Server) undo(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err == nil {
+		err = c.Undo()
+		if err != nil {
+			err = conflict(err)
+		}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newCompetitionView(c))
+}
+
+func (s *Server) redo(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err == nil {
+		err = c.Redo()
+		if err != nil {
+			err = conflict(err)
+		}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newCompetitionView(c))
+}
+
+func (s *Server) listArenas(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	views := []arenaView{}
+	for _, a := range c.GetArenas() {
+		views = append(views, newArenaView(a))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) createArena(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	a := c.CreateArena(req.Name)
+	writeJSON(w, http.StatusCreated, arenaView{Name: a.GetName()})
+}
+
+func (s *Server) getArena(w http.ResponseWriter, r *http.Request) {
+	a, err := s.arena(r, mux.Vars(r)["arena"])
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newArenaView(a))
+}
+
+func (s *Server) assignArenas(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := c.AssignArenas(); err != nil {
+		writeError(w, conflict(err))
+		return
+	}
+	views := []arenaView{}
+	for _, a := range c.GetArenas() {
+		views = append(views, newArenaView(a))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) listOfficials(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	views := []officialView{}
+	for _, o := range c.GetOfficials() {
+		views = append(views, newOfficialView(o))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) createOfficial(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Name   string `json:"name"`
+		Player string `json:"player"` // Who the official plays as, if they also play
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("An official needs a name"))
+		return
+	}
+	var p models.Player
+	if req.Player != "" {
+		if p = s.player(req.Player); p == nil {
+			writeError(w, notFound("No player named %q", req.Player))
+			return
+		}
+	}
+	o := c.CreateOfficial(req.Name, p)
+	writeJSON(w, http.StatusCreated, newOfficialView(o))
+}
+
+func (s *Server) setLosersRef(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Role string `json:"role"` // Empty to stop losing teams officiating
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	c.SetLosersRef(req.Role)
+	writeJSON(w, http.StatusOK, newCompetitionView(c))
+}
+
+func (s *Server) listTournaments(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	views := []tournamentView{}
+	for _, t := range c.GetAllTournaments() {
+		views = append(views, newTournamentView(t))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) createTournament(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Name      string `json:"name"`
+		Type      string `json:"type"`
+		Seeded    bool   `json:"seeded"`
+		GameSize  uint32 `json:"gameSize"`
+		Advancing uint32 `json:"advancing"`
+		Scored    bool   `json:"scored"`
+		Rounds    uint32 `json:"rounds"` // Rounds to play in a Swiss tournament, 0 for enough to separate the teams
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	tournamentType, ok := parseTournamentType(req.Type)
+	if !ok {
+		writeError(w, badRequest("Unknown tournament type %q", req.Type))
+		return
+	}
+	if req.GameSize == 0 {
+		req.GameSize = 2
+	}
+	if req.Advancing == 0 {
+		req.Advancing = 1
+	}
+	t := c.AddTournament(req.Name, tournamentType, nil, req.Seeded, req.GameSize, req.Advancing, req.Scored)
+	if req.Rounds > 0 {
+		t.SetRoundCount(req.Rounds)
+	}
+	if wrapped, err := tournament.Wrap(t); err == nil {
+		t = wrapped
+	}
+	writeJSON(w, http.StatusCreated, newTournamentView(t))
+}
+
+func (s *Server) getTournament(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newTournamentView(t))
+}
+
+func (s *Server) getStandings(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	views := []standingView{}
+	for _, standing := range tournament.Standings(t) {
+		views = append(views, newStandingView(standing))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) listTeams(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	views := []teamView{}
+	for _, team := range t.GetTeams() {
+		views = append(views, newTeamView(team))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) createTeam(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Name    string   `json:"name"`
+		Players []string `json:"players"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	players := []models.Player{}
+	for _, name := range req.Players {
+		p := s.player(name)
+		if p == nil {
+			p = s.engine.CreatePlayer(name, nil)
+		}
+		players = append(players, p)
+	}
+	team := t.CreateTeam(req.Name, players, nil)
+	writeJSON(w, http.StatusCreated, newTeamView(team))
+}
+
+func (s *Server) getTeam(w http.ResponseWriter, r *http.Request) {
+	_, team, err := s.team(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newTeamView(team))
+}
+
+func (s *Server) teamHeadToHead(w http.ResponseWriter, r *http.Request) {
+	t, team, err := s.team(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	name := mux.Vars(r)["other"]
+	for _, other := range t.GetTeams() {
+		if other.GetName() == name {
+			writeJSON(w, http.StatusOK, newMatchupView(models.HeadToHead(team, other)))
+			return
+		}
+	}
+	writeError(w, notFound("No team named %q", name))
+}
+
+func (s *Server) withdrawTeam(w http.ResponseWriter, r *http.Request) {
+	t, team, err := s.team(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	t.Withdraw(team)
+	_, team, _ = s.team(r)
+	writeJSON(w, http.StatusOK, newTeamView(team))
+}
+
+func (s *Server) listRounds(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	views := []roundView{}
+	for i, round := range t.GetAllRounds() {
+		views = append(views, newRoundView(i+1, round))
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+// nextRound advances the tournament, creating its next round from the results of the last one
+func (s *Server) nextRound(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	round, err := t.NextRound()
+	if err != nil {
+		writeError(w, conflict(err))
+		return
+	}
+	writeJSON(w, http.StatusCreated, newRoundView(len(t.GetAllRounds()), round))
+}
+
+func (s *Server) getRound(w http.ResponseWriter, r *http.Request) {
+	_, round, number, err := s.round(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newRoundView(number, round))
+}
+
+func (s *Server) startRound(w http.ResponseWriter, r *http.Request) {
+	_, round, number, err := s.round(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	round.Start()
+	writeJSON(w, http.StatusOK, newRoundView(number, round))
+}
+
+func (s *Server) finishRound(w http.ResponseWriter, r *http.Request) {
+	_, round, number, err := s.round(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	round.SetFinal()
+	writeJSON(w, http.StatusOK, newRoundView(number, round))
+}
+
+func (s *Server) getGame(w http.ResponseWriter, r *http.Request) {
+	_, game, number, err := s.game(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) startGame(w http.ResponseWriter, r *http.Request) {
+	_, game, number, err := s.game(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	game.Start()
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+// openGame finds the game for the request, and makes sure its result can still be changed without a correction
+func (s *Server) openGame(r *http.Request) (models.Game, int, error) {
+	_, game, number, err := s.game(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	if game.GetStatus() == models.Status_COMPLETED {
+		return nil, 0, conflict(fmt.Errorf("Game is already final, submit a correction instead"))
+	}
+	return game, number, nil
+}
+
+func (s *Server) setScores(w http.ResponseWriter, r *http.Request) {
+	game, number, err := s.openGame(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Scores []int64 `json:"scores"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(req.Scores) != len(game.GetTeams()) {
+		writeError(w, badRequest("Expected %d scores, got %d", len(game.GetTeams()), len(req.Scores)))
+		return
+	}
+	game.SetScores(req.Scores)
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) setPlaces(w http.ResponseWriter, r *http.Request) {
+	game, number, err := s.openGame(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Places []int64 `json:"places"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(req.Places) != len(game.GetTeams()) {
+		writeError(w, badRequest("Expected %d places, got %d", len(game.GetTeams()), len(req.Places)))
+		return
+	}
+	game.SetPlaces(req.Places)
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) setArena(w http.ResponseWriter, r *http.Request) {
+	_, game, number, err := s.game(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Arena string `json:"arena"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	a, err := s.arena(r, req.Arena)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	game.SetArena(a)
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) assignOfficial(w http.ResponseWriter, r *http.Request) {
+	_, game, number, err := s.game(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Official string `json:"official"`
+		Role     string `json:"role"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Role == "" {
+		writeError(w, badRequest("An official needs a role"))
+		return
+	}
+	o, err := s.official(r, req.Official)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if models.PlaysIn(o, game) {
+		writeError(w, conflict(fmt.Errorf("%s plays in this game", o.GetName())))
+		return
+	}
+	game.AssignOfficial(o, req.Role)
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) finishGame(w http.ResponseWriter, r *http.Request) {
+	game, number, err := s.openGame(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	game.SetFinal()
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) correctGame(w http.ResponseWriter, r *http.Request) {
+	t, game, number, err := s.game(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	var req struct {
+		Official string  `json:"official"`
+		Scores   []int64 `json:"scores"`
+		Places   []int64 `json:"places"`
+		Replay   bool    `json:"replay"` // Remove later rounds that have already been played
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	_, err = tournament.CorrectGame(t, game, req.Official, req.Scores, req.Places, req.Replay)
+	if err != nil {
+		writeError(w, conflict(err))
+		return
+	}
+	_, game, number, err = s.game(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newGameView(number, game))
+}
+
+func (s *Server) listPlayers(w http.ResponseWriter, r *http.Request) {
+	views := []playerView{}
+	for _, p := range s.engine.GetPlayers() {
+		views = append(views, playerView{Name: p.GetName(), Played: len(p.GetRecords())})
+	}
+	writeJSON(w, http.StatusOK, views)
+}
+
+func (s *Server) createPlayer(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := readJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Name == "" {
+		writeError(w, badRequest("A player needs a name"))
+		return
+	}
+	p := s.engine.CreatePlayer(req.Name, nil)
+	writeJSON(w, http.StatusCreated, playerView{Name: p.GetName()})
+}
+
+func (s *Server) getPlayer(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["player"]
+	p := s.player(name)
+	if p == nil {
+		writeError(w, notFound("No player named %q", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, playerView{Name: p.GetName(), Played: len(p.GetRecords())})
+}
+
+func (s *Server) playerStats(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["player"]
+	p := s.player(name)
+	if p == nil {
+		writeError(w, notFound("No player named %q", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, newPlayerStatsView(p))
+}
+
+func (s *Server) playerHeadToHead(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	var players [2]models.Player
+	for i, name := range []string{vars["player"], vars["other"]} {
+		players[i] = s.player(name)
+		if players[i] == nil {
+			writeError(w, notFound("No player named %q", name))
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, newMatchupView(models.PlayerHeadToHead(players[0], players[1])))
+}
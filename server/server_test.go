@@ -0,0 +1,196 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justinjudd/competition/internal/testutil"
+)
+
+// call sends a request to the server, decoding the JSON response into v if it isn't nil, and returns the status
+func call(t *testing.T, s http.Handler, method, path string, body interface{}, v interface{}) int {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, httptest.NewRequest(method, path, &buf))
+	if v != nil {
+		if err := json.NewDecoder(w.Body).Decode(v); err != nil {
+			t.Fatalf("%s %s: unable to decode response: %v", method, path, err)
+		}
+	}
+	return w.Code
+}
+
+// newTestServer creates a server with a single elimination tournament of four teams, Spring/Open
+func newTestServer(t *testing.T) *Server {
+	s := NewServer(testutil.NewEngine(t))
+	if code := call(t, s, http.MethodPost, "/competitions", map[string]string{"name": "Spring"}, nil); code != http.StatusCreated {
+		t.Fatalf("Creating a competition returned %d", code)
+	}
+	tournament := map[string]interface{}{"name": "Open", "type": "SINGLE_ELIMINATION", "scored": true}
+	if code := call(t, s, http.MethodPost, "/competitions/Spring/tournaments", tournament, nil); code != http.StatusCreated {
+		t.Fatalf("Creating a tournament returned %d", code)
+	}
+	for _, name := range []string{"Ann", "Bob", "Cal", "Dee"} {
+		team := map[string]interface{}{"name": name, "players": []string{name}}
+		if code := call(t, s, http.MethodPost, "/competitions/Spring/tournaments/Open/teams", team, nil); code != http.StatusCreated {
+			t.Fatalf("Creating team %s returned %d", name, code)
+		}
+	}
+	return s
+}
+
+func TestRoutes(t *testing.T) {
+	s := newTestServer(t)
+	const open = "/competitions/Spring/tournaments/Open"
+
+	var tourney tournamentView
+	if code := call(t, s, http.MethodGet, open, nil, &tourney); code != http.StatusOK {
+		t.Fatalf("Getting the tournament returned %d", code)
+	}
+	if tourney.Type != "SINGLE_ELIMINATION" || len(tourney.Teams) != 4 || tourney.Status != "NEW" {
+		t.Errorf("Tournament is %+v, expected a new single elimination tournament of 4 teams", tourney)
+	}
+
+	var round roundView
+	if code := call(t, s, http.MethodPost, open+"/rounds", nil, &round); code != http.StatusCreated {
+		t.Fatalf("Creating a round returned %d", code)
+	}
+	if round.Number != 1 || len(round.Games) != 2 {
+		t.Fatalf("Round is %+v, expected round 1 with 2 games", round)
+	}
+
+	var game gameView
+	if code := call(t, s, http.MethodPut, open+"/rounds/1/games/1/scores", map[string][]int64{"scores": {3, 1}}, &game); code != http.StatusOK {
+		t.Fatalf("Setting scores returned %d", code)
+	}
+	if code := call(t, s, http.MethodPost, open+"/rounds/1/games/1/final", nil, &game); code != http.StatusOK {
+		t.Fatalf("Finishing a game returned %d", code)
+	}
+	if game.Status != "COMPLETED" || game.Results[0] != "WIN" || game.Results[1] != "LOSS" {
+		t.Errorf("Finished game is %+v, expected the first team to have won", game)
+	}
+	if code := call(t, s, http.MethodPut, open+"/rounds/1/games/1/scores", map[string][]int64{"scores": {1, 3}}, nil); code != http.StatusConflict {
+		t.Errorf("Setting the scores of a final game returned %d, expected %d", code, http.StatusConflict)
+	}
+	if code := call(t, s, http.MethodPost, open+"/rounds", nil, nil); code != http.StatusConflict {
+		t.Errorf("Creating a round before the last one is over returned %d, expected %d", code, http.StatusConflict)
+	}
+
+	var errorBody map[string]string
+	for _, path := range []string{"/competitions/Fall", "/competitions/Spring/tournaments/Closed", open + "/rounds/2", open + "/rounds/1/games/3"} {
+		if code := call(t, s, http.MethodGet, path, nil, &errorBody); code != http.StatusNotFound || errorBody["error"] == "" {
+			t.Errorf("GET %s returned %d %v, expected %d with an error", path, code, errorBody, http.StatusNotFound)
+		}
+	}
+	if code := call(t, s, http.MethodPut, open+"/rounds/1/games/2/scores", map[string][]int64{"scores": {1}}, nil); code != http.StatusBadRequest {
+		t.Errorf("Setting too few scores returned %d, expected %d", code, http.StatusBadRequest)
+	}
+	if code := call(t, s, http.MethodPost, "/competitions/Spring/tournaments", map[string]string{"name": "Odd", "type": "KNOCKOUT"}, nil); code != http.StatusBadRequest {
+		t.Errorf("Creating a tournament of an unknown type returned %d, expected %d", code, http.StatusBadRequest)
+	}
+}
+
+func TestWritesWaitTheirTurn(t *testing.T) {
+	s := newTestServer(t)
+
+	s.mu.Lock() // As if another change was being made
+	done := make(chan int)
+	go func() {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/competitions", strings.NewReader(`{"name": "Fall"}`)))
+		done <- w.Code
+	}()
+
+	var competitions []competitionView
+	if code := call(t, s, http.MethodGet, "/competitions", nil, &competitions); code != http.StatusOK || len(competitions) != 1 {
+		t.Errorf("Reading during a change returned %d with %d competitions, expected 1", code, len(competitions))
+	}
+	select {
+	case <-done:
+		t.Fatal("Change was made while another was being made")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	s.mu.Unlock()
+	select {
+	case code := <-done:
+		if code != http.StatusCreated {
+			t.Errorf("Waiting change returned %d", code)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Waiting change wasn't made once the other was done")
+	}
+}
+
+func TestWatch(t *testing.T) {
+	s := newTestServer(t)
+	const open = "/competitions/Spring/tournaments/Open"
+	if code := call(t, s, http.MethodPost, open+"/rounds", nil, nil); code != http.StatusCreated {
+		t.Fatalf("Creating a round returned %d", code)
+	}
+
+	server := httptest.NewServer(s)
+	defer server.Close()
+	resp, err := http.Get(server.URL + open + "/events")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Events are sent as %q", ct)
+	}
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+	next := func() string {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatal("Event stream ended")
+			}
+			return line
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for an event")
+		}
+		return ""
+	}
+	if line := next(); line != ": connected" {
+		t.Fatalf("Stream started with %q", line)
+	}
+	next() // Blank line ending the comment
+
+	if code := call(t, s, http.MethodPut, open+"/rounds/1/games/2/scores", map[string][]int64{"scores": {2, 5}}, nil); code != http.StatusOK {
+		t.Fatalf("Setting scores returned %d", code)
+	}
+	if line := next(); line != "event: game" {
+		t.Fatalf("Expected a game event, got %q", line)
+	}
+	var change changeView
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(next(), "data: ")), &change); err != nil {
+		t.Fatal(err)
+	}
+	if change.Competition != "Spring" || change.Tournament != "Open" || change.Round != 1 || change.Game != 2 {
+		t.Errorf("Change is for %s/%s round %d game %d, expected Spring/Open round 1 game 2", change.Competition, change.Tournament, change.Round, change.Game)
+	}
+	if change.State == nil || len(change.State.Scores) != 2 || change.State.Scores[0] != 2 || change.State.Scores[1] != 5 {
+		t.Errorf("Changed game is %+v, expected scores [2 5]", change.State)
+	}
+}
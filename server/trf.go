@@ -0,0 +1,44 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/justinjudd/competition"
+)
+
+// Chess tournaments are moved in and out as FIDE Tournament Report Files, in the request or response body
+
+func (s *Server) exportTRF(w http.ResponseWriter, r *http.Request) {
+	t, err := s.tournament(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	data, err := competition.ExportTRF(t)
+	if err != nil {
+		writeError(w, badRequest("%v", err))
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(data)
+}
+
+func (s *Server) importTRF(w http.ResponseWriter, r *http.Request) {
+	c, err := s.competition(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, badRequest("Invalid request body: %v", err))
+		return
+	}
+	t, err := competition.ImportTRF(s.engine, c, data)
+	if err != nil {
+		writeError(w, badRequest("%v", err))
+		return
+	}
+	writeJSON(w, http.StatusCreated, newTournamentView(t))
+}
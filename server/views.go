@@ -0,0 +1,301 @@
+package server
+
+import (
+	"time"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+var statusNames = map[models.Status]string{
+	models.Status_NEW:       "NEW",
+	models.Status_ONGOING:   "ONGOING",
+	models.Status_COMPLETED: "COMPLETED",
+}
+
+var tournamentTypeNames = map[models.TournamentType]string{
+	models.TournamentType_SINGLE_ELIMINATION: "SINGLE_ELIMINATION",
+	models.TournamentType_DOUBLE_ELIMINATION: "DOUBLE_ELIMINATION",
+	models.TournamentType_ROUND_ROBIN:        "ROUND_ROBIN",
+	models.TournamentType_COMPASS_DRAW:       "COMPASS_DRAW",
+	models.TournamentType_SWISS_FORMAT:       "SWISS_FORMAT",
+	models.TournamentType_GROUP_PLAY:         "GROUP_PLAY",
+}
+
+var resultNames = map[models.Result]string{
+	models.Result_UNDECIDED:    "UNDECIDED",
+	models.Result_WIN:          "WIN",
+	models.Result_LOSS:         "LOSS",
+	models.Result_DRAW:         "DRAW",
+	models.Result_FORFEIT:      "FORFEIT",
+	models.Result_DISQUALIFIED: "DISQUALIFIED",
+	models.Result_WALKOVER:     "WALKOVER",
+}
+
+// parseTournamentType looks up a tournament type by its name
+func parseTournamentType(name string) (models.TournamentType, bool) {
+	for t, n := range tournamentTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+type competitionView struct {
+	Name        string   `json:"name"`
+	Tournaments []string `json:"tournaments"`
+	Arenas      []string `json:"arenas"`
+	LosersRef   string   `json:"losersRef,omitempty"` // Role the losing team of a game takes in the next game at its arena
+}
+
+type tournamentView struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Status    string   `json:"status"`
+	GameSize  uint32   `json:"gameSize"`
+	Advancing uint32   `json:"advancing"`
+	Seeded    bool     `json:"seeded"`
+	Scored    bool     `json:"scored"`
+	Teams     []string `json:"teams"`
+	Brackets  []string `json:"brackets"`
+	Rounds    int      `json:"rounds"`
+	Planned   uint32   `json:"planned,omitempty"` // Rounds to be played, when the tournament sets how many
+}
+
+type roundView struct {
+	Number int        `json:"number"`
+	Status string     `json:"status"`
+	Games  []gameView `json:"games"`
+}
+
+type gameView struct {
+	Number    int        `json:"number"`
+	Bracket   string     `json:"bracket"`
+	Status    string     `json:"status"`
+	Teams     []string   `json:"teams"`
+	Scores    []int64    `json:"scores"`
+	Places    []int64    `json:"places"`
+	Results   []string   `json:"results"`
+	Arena     string     `json:"arena,omitempty"`
+	Start     *time.Time `json:"start,omitempty"` // Set once the game has been scheduled
+	End       *time.Time `json:"end,omitempty"`
+	Officials []dutyView `json:"officials,omitempty"`
+}
+
+// dutyView is an official's role in a game
+type dutyView struct {
+	Official string `json:"official"`
+	Role     string `json:"role"`
+}
+
+type officialView struct {
+	Name   string `json:"name"`
+	Player string `json:"player,omitempty"` // Who the official plays as
+	Team   string `json:"team,omitempty"`   // Set when a team officiates as a whole
+	Games  int    `json:"games"`
+}
+
+type teamView struct {
+	Name      string   `json:"name"`
+	Players   []string `json:"players"`
+	Withdrawn bool     `json:"withdrawn"`
+	Played    int      `json:"played"`
+}
+
+type playerView struct {
+	Name   string `json:"name"`
+	Played int    `json:"played"`
+}
+
+type statsView struct {
+	Played        int     `json:"played"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Draws         int     `json:"draws"`
+	WinRate       float64 `json:"winRate"`
+	PointsFor     int64   `json:"pointsFor"`
+	PointsAgainst int64   `json:"pointsAgainst"`
+	Titles        int     `json:"titles"`
+	BestFinish    int     `json:"bestFinish,omitempty"` // Left out if the player hasn't finished a tournament
+}
+
+func newStatsView(s models.Stats) statsView {
+	return statsView{s.Played, s.Wins, s.Losses, s.Draws, s.WinRate(), s.PointsFor, s.PointsAgainst, s.Titles, s.BestFinish}
+}
+
+type playerStatsView struct {
+	Name             string               `json:"name"`
+	Total            statsView            `json:"total"`
+	ByCompetition    map[string]statsView `json:"byCompetition"`
+	ByTournamentType map[string]statsView `json:"byTournamentType"`
+	ByTeammate       map[string]statsView `json:"byTeammate"`
+}
+
+func newPlayerStatsView(p models.Player) playerStatsView {
+	stats := p.GetStats()
+	v := playerStatsView{
+		Name:             p.GetName(),
+		Total:            newStatsView(stats.Total),
+		ByCompetition:    map[string]statsView{},
+		ByTournamentType: map[string]statsView{},
+		ByTeammate:       map[string]statsView{},
+	}
+	for name, s := range stats.ByCompetition {
+		v.ByCompetition[name] = newStatsView(s)
+	}
+	for t, s := range stats.ByTournamentType {
+		v.ByTournamentType[tournamentTypeNames[t]] = newStatsView(s)
+	}
+	for name, s := range stats.ByTeammate {
+		v.ByTeammate[name] = newStatsView(s)
+	}
+	return v
+}
+
+// matchupView is how one team or player has done against another
+type matchupView struct {
+	Played        int        `json:"played"`
+	Wins          int        `json:"wins"`
+	Losses        int        `json:"losses"`
+	Draws         int        `json:"draws"`
+	PointsFor     int64      `json:"pointsFor"`
+	PointsAgainst int64      `json:"pointsAgainst"`
+	Games         []gameView `json:"games"` // Numbered in the order they were played
+}
+
+func newMatchupView(m models.Matchup) matchupView {
+	v := matchupView{Played: m.Played, Wins: m.Wins, Losses: m.Losses, Draws: m.Draws, PointsFor: m.PointsFor, PointsAgainst: m.PointsAgainst, Games: []gameView{}}
+	for i, g := range m.Games {
+		v.Games = append(v.Games, newGameView(i+1, g))
+	}
+	return v
+}
+
+type arenaView struct {
+	Name  string   `json:"name"`
+	Games int      `json:"games"`          // Games waiting to be played in the arena
+	Next  []string `json:"next,omitempty"` // Teams of the game being played in the arena, or else the next game ready to be played
+}
+
+type standingView struct {
+	Team          string `json:"team"`
+	Played        int    `json:"played"`
+	Wins          int    `json:"wins"`
+	Losses        int    `json:"losses"`
+	Draws         int    `json:"draws"`
+	PointsFor     int64  `json:"pointsFor"`
+	PointsAgainst int64  `json:"pointsAgainst"`
+	Withdrawn     bool   `json:"withdrawn"`
+	HeadToHead    int    `json:"headToHead"` // Wins less losses against the teams level with this one
+}
+
+func newCompetitionView(c models.Competition) competitionView {
+	v := competitionView{Name: c.GetName(), Tournaments: []string{}, Arenas: []string{}, LosersRef: c.GetLosersRef()}
+	for _, t := range c.GetAllTournaments() {
+		v.Tournaments = append(v.Tournaments, t.GetName())
+	}
+	for _, a := range c.GetArenas() {
+		v.Arenas = append(v.Arenas, a.GetName())
+	}
+	return v
+}
+
+func newTournamentView(t models.Tournament) tournamentView {
+	v := tournamentView{
+		Name:      t.GetName(),
+		Type:      tournamentTypeNames[t.GetType()],
+		Status:    statusNames[t.GetStatus()],
+		GameSize:  t.GetGameSize(),
+		Advancing: t.GetAdvancing(),
+		Seeded:    t.IsSeeded(),
+		Scored:    t.IsScored(),
+		Teams:     []string{},
+		Brackets:  t.GetBracketOrder(),
+		Rounds:    len(t.GetAllRounds()),
+		Planned:   t.GetRoundCount(),
+	}
+	for _, team := range t.GetTeams() {
+		v.Teams = append(v.Teams, team.GetName())
+	}
+	return v
+}
+
+func newRoundView(number int, r models.Round) roundView {
+	v := roundView{Number: number, Status: statusNames[r.GetStatus()], Games: []gameView{}}
+	for i, g := range r.GetGames() {
+		v.Games = append(v.Games, newGameView(i+1, g))
+	}
+	return v
+}
+
+func newGameView(number int, g models.Game) gameView {
+	v := gameView{
+		Number:  number,
+		Bracket: g.GetBracket(),
+		Status:  statusNames[g.GetStatus()],
+		Teams:   []string{},
+		Scores:  g.GetScores(),
+		Places:  g.GetPlaces(),
+		Results: []string{},
+	}
+	for _, t := range g.GetTeams() {
+		v.Teams = append(v.Teams, t.GetName())
+	}
+	for _, r := range g.GetResults() {
+		v.Results = append(v.Results, resultNames[r])
+	}
+	if a := g.GetArena(); a != nil {
+		v.Arena = a.GetName()
+	}
+	if start, end := g.GetSchedule(); !start.IsZero() {
+		v.Start, v.End = &start, &end
+	}
+	for _, a := range g.GetOfficials() {
+		v.Officials = append(v.Officials, dutyView{Official: a.Official.GetName(), Role: a.Role})
+	}
+	return v
+}
+
+func newOfficialView(o models.Official) officialView {
+	v := officialView{Name: o.GetName(), Games: len(o.GetGames())}
+	if p := o.GetPlayer(); p != nil {
+		v.Player = p.GetName()
+	}
+	if t := o.GetTeam(); t != nil {
+		v.Team = t.GetName()
+	}
+	return v
+}
+
+func newArenaView(a models.Arena) arenaView {
+	v := arenaView{Name: a.GetName(), Games: len(a.GetGames())}
+	if next := a.NextGame(); next != nil {
+		for _, t := range next.GetTeams() {
+			v.Next = append(v.Next, t.GetName())
+		}
+	}
+	return v
+}
+
+func newTeamView(t models.Team) teamView {
+	v := teamView{Name: t.GetName(), Players: []string{}, Withdrawn: t.IsWithdrawn(), Played: len(t.GetRecords())}
+	for _, p := range t.GetPlayers() {
+		v.Players = append(v.Players, p.GetName())
+	}
+	return v
+}
+
+func newStandingView(s tournament.Standing) standingView {
+	return standingView{
+		Team:          s.Team.GetName(),
+		Played:        s.Played,
+		Wins:          s.Wins + s.Walkovers,
+		Losses:        s.Losses + s.Forfeits + s.Disqualified,
+		Draws:         s.Draws,
+		PointsFor:     s.PointsFor,
+		PointsAgainst: s.PointsAgainst,
+		Withdrawn:     s.Withdrawn,
+		HeadToHead:    s.HeadToHead,
+	}
+}
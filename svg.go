@@ -0,0 +1,231 @@
+package competition
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// Sizes used to lay out SVG brackets, in pixels
+const (
+	svgMargin      = 20
+	svgTitleHeight = 40
+	svgHeaderSize  = 44 // Space for the name of a bracket and its round labels
+	svgTeamHeight  = 24
+	svgGameWidth   = 180
+	svgColumnGap   = 40
+	svgGameGap     = 16
+	svgLogoSize    = 18
+)
+
+const svgStyle = `
+text { font-family: Helvetica, Arial, sans-serif; font-size: 13px; fill: #222; }
+.title { font-size: 20px; font-weight: bold; }
+.bracket-name { font-size: 15px; font-weight: bold; }
+.round { font-size: 11px; fill: #777; }
+.game { fill: #fff; stroke: #999; }
+.divider { stroke: #ddd; }
+.winner { fill: #eef6e8; }
+.winner-name { font-weight: bold; }
+.bye { fill: #999; font-style: italic; }
+.result { font-size: 10px; fill: #b00; }
+.link { fill: none; stroke: #666; stroke-width: 1.5; }
+`
+
+// svgGame is where a game is drawn
+type svgGame struct {
+	game  models.Game // nil for the box with the winner of a bracket
+	x, y  float64
+	teams []models.Team // nil entries are byes
+}
+
+// row returns the y of the middle of a team's row in the game, -1 if the team isn't in it
+func (g svgGame) row(team models.Team) float64 {
+	for i, t := range g.teams {
+		if t != nil && t.GetName() == team.GetName() {
+			return g.y + float64(i)*svgTeamHeight + svgTeamHeight/2
+		}
+	}
+	return -1
+}
+
+// GenerateTournamentSVG draws the brackets of a tournament as a standalone SVG image, for printing or embedding in documents and slides.
+// Brackets are stacked with their rounds lined up, and lines connect each game to the next game its winners play.
+// Team logos come from GetMetadata, either as image data, which is embedded, or as a link to the image
+func GenerateTournamentSVG(t models.Tournament) ([]byte, error) {
+	brackets := tournamentBrackets(t)
+	columns := len(t.GetAllRounds())
+
+	var body bytes.Buffer
+	placed := map[gamePosition]svgGame{}
+
+	y := float64(svgMargin + svgTitleHeight)
+	for bi, b := range brackets {
+		fmt.Fprintf(&body, `<text class="bracket-name" x="%d" y="%g">%s</text>`+"\n", svgMargin, y+15, html.EscapeString(b.Name))
+		top := y + svgHeaderSize
+
+		// Like the text bracket, columns are as tall as the tallest column with a gap between its games, and games are spread evenly down them
+		height := 0.0
+		for _, round := range b.Rounds {
+			h := float64(len(round)-1) * svgGameGap
+			for _, game := range round {
+				h += float64(len(gameSlots(game))) * svgTeamHeight
+			}
+			if h > height {
+				height = h
+			}
+		}
+
+		for i, round := range b.Rounds {
+			x := float64(svgMargin + i*(svgGameWidth+svgColumnGap))
+			if len(round) > 0 {
+				fmt.Fprintf(&body, `<text class="round" x="%g" y="%g">Round %d</text>`+"\n", x, top-6, i+1)
+			}
+			used := 0.0
+			for _, game := range round {
+				used += float64(len(gameSlots(game))) * svgTeamHeight
+			}
+			extra := height - used - float64(len(round)-1)*svgGameGap
+			offset := 0.0
+			for j, game := range round {
+				g := svgGame{game: game, x: x, teams: gameSlots(game)}
+				g.y = top + offset + float64(j)*svgGameGap + extra*float64(2*j+1)/float64(2*len(round))
+				offset += float64(len(g.teams)) * svgTeamHeight
+				placed[gamePosition{bi, i, j}] = g
+				writeSVGGame(&body, g, b)
+			}
+		}
+
+		if winner := b.winner(); winner != nil {
+			final := placed[gamePosition{bi, len(b.Rounds) - 1, 0}]
+			box := svgGame{x: float64(svgMargin + columns*(svgGameWidth+svgColumnGap)), teams: []models.Team{winner}}
+			box.y = final.row(winner) - svgTeamHeight/2
+			fmt.Fprintf(&body, `<text class="round" x="%g" y="%g">Winner</text>`+"\n", box.x, top-6)
+			writeSVGTeam(&body, box, 0, true, b)
+			fmt.Fprintf(&body, `<rect class="game" x="%g" y="%g" width="%d" height="%d" fill-opacity="0"/>`+"\n", box.x, box.y, svgGameWidth, svgTeamHeight)
+			fmt.Fprintf(&body, `<path class="link" d="M%g %gH%g"/>`+"\n", final.x+svgGameWidth, box.y+svgTeamHeight/2, box.x)
+		}
+
+		y = top + height + svgMargin*2
+	}
+
+	// Connect each game to the next game its winners play, which may be in another bracket
+	links := winnerLinks(brackets)
+	for bi, b := range brackets {
+		for i, round := range b.Rounds {
+			for j := range round {
+				from := placed[gamePosition{bi, i, j}]
+				for _, link := range links[gamePosition{bi, i, j}] {
+					to := placed[link.To]
+					x1, y1 := from.x+svgGameWidth, from.row(link.Team)
+					x2, y2 := to.x, to.row(link.Team)
+					mid := x2 - svgColumnGap/2
+					fmt.Fprintf(&body, `<path class="link" d="M%g %gH%gV%gH%g"/>`+"\n", x1, y1, mid, y2, x2)
+				}
+			}
+		}
+	}
+
+	width := svgMargin*2 + (columns+1)*(svgGameWidth+svgColumnGap)
+	var out bytes.Buffer
+	fmt.Fprintf(&out, `<?xml version="1.0" encoding="UTF-8"?>`+"\n")
+	fmt.Fprintf(&out, `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" width="%d" height="%g" viewBox="0 0 %d %g">`+"\n", width, y, width, y)
+	fmt.Fprintf(&out, "<style>%s</style>\n", svgStyle)
+	fmt.Fprintf(&out, `<rect width="100%%" height="100%%" fill="#fff"/>`+"\n")
+	fmt.Fprintf(&out, `<text class="title" x="%d" y="%d">%s</text>`+"\n", svgMargin, svgMargin+20, html.EscapeString(t.GetName()))
+	out.Write(body.Bytes())
+	out.WriteString("</svg>\n")
+	return out.Bytes(), nil
+}
+
+// gameSlots returns the teams of a game, with a nil entry for the bye of a team that is alone in its game
+func gameSlots(game models.Game) []models.Team {
+	var slots []models.Team
+	for _, team := range game.GetTeams() {
+		if models.IsByeTeam(team) {
+			team = nil
+		}
+		slots = append(slots, team)
+	}
+	if len(slots) < 2 {
+		slots = append(slots, nil)
+	}
+	return slots
+}
+
+func writeSVGGame(w *bytes.Buffer, g svgGame, b Bracket) {
+	height := len(g.teams) * svgTeamHeight
+	fmt.Fprintf(w, `<g><rect class="game" x="%g" y="%g" width="%d" height="%d"/>`+"\n", g.x, g.y, svgGameWidth, height)
+	for i, team := range g.teams {
+		writeSVGTeam(w, g, i, team != nil && IsWinner(team, g.game, b.Advance), b)
+		if i > 0 {
+			fmt.Fprintf(w, `<line class="divider" x1="%g" y1="%g" x2="%g" y2="%g"/>`+"\n", g.x, g.y+float64(i*svgTeamHeight), g.x+svgGameWidth, g.y+float64(i*svgTeamHeight))
+		}
+	}
+	fmt.Fprintf(w, `<rect class="game" x="%g" y="%g" width="%d" height="%d" fill-opacity="0"/></g>`+"\n", g.x, g.y, svgGameWidth, height)
+}
+
+// writeSVGTeam draws the row of a team in a game: its logo, name, result marker and score
+func writeSVGTeam(w *bytes.Buffer, g svgGame, i int, winner bool, b Bracket) {
+	team := g.teams[i]
+	top := g.y + float64(i*svgTeamHeight)
+	baseline := top + svgTeamHeight/2 + 4.5
+	if team == nil {
+		fmt.Fprintf(w, `<text class="bye" x="%g" y="%g">BYE</text>`+"\n", g.x+6, baseline)
+		return
+	}
+	if winner {
+		fmt.Fprintf(w, `<rect class="winner" x="%g" y="%g" width="%d" height="%d"/>`+"\n", g.x, top, svgGameWidth, svgTeamHeight)
+	}
+
+	x := g.x + 6
+	if logo := svgLogo(team.GetMetadata()); logo != "" {
+		fmt.Fprintf(w, `<image x="%g" y="%g" width="%d" height="%d" xlink:href="%s" href="%s"/>`+"\n", x, top+(svgTeamHeight-svgLogoSize)/2, svgLogoSize, svgLogoSize, logo, logo)
+		x += svgLogoSize + 4
+	}
+	class := ""
+	if winner {
+		class = ` class="winner-name"`
+	}
+	fmt.Fprintf(w, `<text%s x="%g" y="%g">%s`, class, x, baseline, html.EscapeString(team.GetName()))
+	if g.game == nil {
+		w.WriteString("</text>\n")
+		return
+	}
+	if result := resultMarker(g.game, team); result != "" {
+		fmt.Fprintf(w, ` <tspan class="result">%s</tspan>`, html.EscapeString(result))
+	}
+	w.WriteString("</text>\n")
+
+	// Scores are left off games that haven't started, rather than showing every team on 0
+	scores := g.game.GetScores()
+	if b.Scored && i < len(scores) && (g.game.GetStatus() != models.Status_NEW || hasScores(g.game)) {
+		fmt.Fprintf(w, `<text x="%g" y="%g" text-anchor="end">%d</text>`+"\n", g.x+svgGameWidth-6, baseline, scores[i])
+	}
+}
+
+// hasScores reports whether any team in a game has scored
+func hasScores(game models.Game) bool {
+	for _, s := range game.GetScores() {
+		if s != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// svgLogo returns the link to use for a team logo. Image data is embedded as a data URI, anything else is taken to be a link to the image
+func svgLogo(metadata []byte) string {
+	if len(metadata) == 0 {
+		return ""
+	}
+	if contentType := http.DetectContentType(metadata); strings.HasPrefix(contentType, "image/") {
+		return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(metadata)
+	}
+	return html.EscapeString(strings.TrimSpace(string(metadata)))
+}
@@ -0,0 +1,128 @@
+package competition
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// svgSummary is what an SVG bracket draws, read back from the image
+type svgSummary struct {
+	title   string
+	games   int
+	byes    int
+	links   int      // Lines between games, and to the winner of a bracket
+	winners []string // Teams drawn as winners, in the order they are drawn
+	outside int      // Boxes that don't fit in the image
+}
+
+func readSVG(t *testing.T, data []byte) svgSummary {
+	var s svgSummary
+	var width, height float64
+	attrs := func(e xml.StartElement) map[string]string {
+		m := map[string]string{}
+		for _, a := range e.Attr {
+			m[a.Name.Local] = a.Value
+		}
+		return m
+	}
+	number := func(v string) float64 {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			t.Fatalf("Invalid number %q: %v", v, err)
+		}
+		return f
+	}
+
+	d := xml.NewDecoder(bytes.NewReader(data))
+	var text string // Class of the text element being read
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Invalid SVG: %v", err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			a := attrs(token)
+			switch token.Name.Local {
+			case "svg":
+				width, height = number(a["width"]), number(a["height"])
+			case "g":
+				s.games++
+			case "path":
+				if a["class"] == "link" {
+					s.links++
+				}
+			case "rect":
+				if a["class"] == "game" && (number(a["x"])+number(a["width"]) > width || number(a["y"])+number(a["height"]) > height) {
+					s.outside++
+				}
+			case "text":
+				text = a["class"]
+				if text == "bye" {
+					s.byes++
+				}
+			}
+		case xml.CharData:
+			switch text {
+			case "title":
+				s.title = string(token)
+			case "winner-name":
+				s.winners = append(s.winners, string(bytes.TrimSpace(token)))
+			}
+			text = ""
+		case xml.EndElement:
+			text = ""
+		}
+	}
+	return s
+}
+
+func TestTournamentSVG(t *testing.T) {
+	tests := []struct {
+		name           string
+		tournamentType models.TournamentType
+		teams          []string
+		rounds         int
+		games, byes    int
+		links          int
+		winners        []string
+	}{
+		{"Single elimination", models.TournamentType_SINGLE_ELIMINATION, fourTeams, 3, 3, 0, 3, []string{"Ants", "Cats", "Ants", "Ants"}},
+		{"Double elimination with a play-in", models.TournamentType_DOUBLE_ELIMINATION, append(fourTeams, "Eels"), 10, 7, 0, 7, []string{"Dogs", "Ants", "Cats", "Ants", "Dogs", "Dogs", "Ants", "Ants"}},
+		{"Bye", models.TournamentType_ROUND_ROBIN, fourTeams[:3], 1, 2, 1, 0, []string{"Ants", "Cats"}},
+	}
+	for _, test := range tests {
+		out, err := GenerateTournamentSVG(playTournament(t, test.tournamentType, test.teams, test.rounds))
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := readSVG(t, out)
+		if s.title != "Cup" {
+			t.Errorf("%s is titled %q, expected Cup", test.name, s.title)
+		}
+		if s.games != test.games || s.byes != test.byes || s.links != test.links {
+			t.Errorf("%s has %d games, %d byes and %d links, expected %d, %d and %d", test.name, s.games, s.byes, s.links, test.games, test.byes, test.links)
+		}
+		if len(s.winners) != len(test.winners) {
+			t.Errorf("%s draws %v as winners, expected %v", test.name, s.winners, test.winners)
+		} else {
+			for i := range s.winners {
+				if s.winners[i] != test.winners[i] {
+					t.Errorf("%s draws %v as winners, expected %v", test.name, s.winners, test.winners)
+					break
+				}
+			}
+		}
+		if s.outside > 0 {
+			t.Errorf("%s has %d games outside of the image", test.name, s.outside)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package competition
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// GenerateTournamentText draws the brackets of a tournament with box characters, for terminals, chat messages and logs.
+// Like GenerateTournamentHTML, every bracket has a column of games for each round, and winners are marked with ▶
+func GenerateTournamentText(t models.Tournament) ([]byte, error) {
+	var out []byte
+	out = append(out, t.GetName()+"\n"+strings.Repeat("═", utf8.RuneCountInString(t.GetName()))+"\n"...)
+
+	for _, bracket := range tournamentBrackets(t) {
+		text, err := bracket.Text()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, '\n')
+		out = append(out, text...)
+	}
+
+	return out, nil
+}
+
+// textBox is a game, or the winner of a bracket, drawn as lines of text
+type textBox []string
+
+// Text draws the bracket with box characters, with a column for every round that has games
+func (b Bracket) Text() ([]byte, error) {
+	var headers []string
+	var columns [][]textBox
+	for i, round := range b.Rounds {
+		if len(round) == 0 {
+			continue
+		}
+		boxes := make([]textBox, len(round))
+		for j, game := range round {
+			boxes[j] = b.gameBox(game)
+		}
+		headers = append(headers, "Round "+strconv.Itoa(i+1))
+		columns = append(columns, boxes)
+	}
+	if winner := b.winner(); winner != nil {
+		headers = append(headers, "Winner")
+		columns = append(columns, []textBox{drawBox([]string{winner.GetName()})})
+	}
+
+	// Columns are as tall as the tallest column with a line between its games, and games are spread evenly down them
+	height := 0
+	for _, boxes := range columns {
+		h := len(boxes) - 1
+		for _, box := range boxes {
+			h += len(box)
+		}
+		if h > height {
+			height = h
+		}
+	}
+
+	lines := make([]string, height+1)
+	for c, boxes := range columns {
+		width := utf8.RuneCountInString(headers[c])
+		used := 0
+		for _, box := range boxes {
+			used += len(box)
+			if w := utf8.RuneCountInString(box[0]); w > width {
+				width = w
+			}
+		}
+		extra := height - used - (len(boxes) - 1) // Space left over once there is a line between each game
+
+		column := make([]string, height)
+		top := 0
+		for i, box := range boxes {
+			row := top + i + extra*(2*i+1)/(2*len(boxes))
+			for k, line := range box {
+				column[row+k] = line
+			}
+			top += len(box)
+		}
+
+		sep := "  "
+		if c == 0 {
+			sep = ""
+		}
+		lines[0] += sep + padText(headers[c], width)
+		for r, line := range column {
+			lines[r+1] += sep + padText(line, width)
+		}
+	}
+
+	var out strings.Builder
+	if b.Name != "" {
+		out.WriteString(b.Name + "\n")
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	for _, line := range lines {
+		out.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	return []byte(out.String()), nil
+}
+
+// gameBox draws a game with a line for each team. Byes are shown as BYE, and scores are lined up on the right when the bracket is scored
+func (b Bracket) gameBox(game models.Game) textBox {
+	teams := game.GetTeams()
+	scores := game.GetScores()
+	names := make([]string, 0, len(teams)+1)
+	points := make([]string, 0, len(teams)+1)
+	for i, team := range teams {
+		if models.IsByeTeam(team) {
+			names = append(names, "  BYE")
+			points = append(points, "")
+			continue
+		}
+		mark := "  "
+		if IsWinner(team, game, b.Advance) {
+			mark = "▶ "
+		}
+		name := mark + team.GetName()
+		if result := resultMarker(game, team); result != "" {
+			name += " " + result
+		}
+		names = append(names, name)
+		score := ""
+		if b.Scored && i < len(scores) {
+			score = strconv.FormatInt(scores[i], 10)
+		}
+		points = append(points, score)
+	}
+	if len(teams) == 1 {
+		names = append(names, "  BYE")
+		points = append(points, "")
+	}
+
+	nameWidth, pointWidth := 0, 0
+	for i := range names {
+		if w := utf8.RuneCountInString(names[i]); w > nameWidth {
+			nameWidth = w
+		}
+		if w := len(points[i]); w > pointWidth {
+			pointWidth = w
+		}
+	}
+	rows := make([]string, len(names))
+	for i := range names {
+		rows[i] = padText(names[i], nameWidth)
+		if pointWidth > 0 {
+			rows[i] += " " + strings.Repeat(" ", pointWidth-len(points[i])) + points[i]
+		}
+	}
+	return drawBox(rows)
+}
+
+// drawBox puts lines of text in a box
+func drawBox(rows []string) textBox {
+	width := 0
+	for _, row := range rows {
+		if w := utf8.RuneCountInString(row); w > width {
+			width = w
+		}
+	}
+	box := textBox{"┌" + strings.Repeat("─", width+2) + "┐"}
+	for _, row := range rows {
+		box = append(box, "│ "+padText(row, width)+" │")
+	}
+	return append(box, "└"+strings.Repeat("─", width+2)+"┘")
+}
+
+// padText pads s with spaces to width characters
+func padText(s string, width int) string {
+	if n := utf8.RuneCountInString(s); n < width {
+		return s + strings.Repeat(" ", width-n)
+	}
+	return s
+}
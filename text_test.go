@@ -0,0 +1,135 @@
+package competition
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// playTournament creates a seeded and scored tournament of single player teams, and plays up to rounds of it with the first team of each game winning 3-1
+func playTournament(t *testing.T, tournamentType models.TournamentType, names []string, rounds int) models.Tournament {
+	e := testutil.NewEngine(t)
+	c := e.CreateCompetition("League", nil)
+	base := c.AddTournament("Cup", tournamentType, nil, true, 2, 1, true)
+	for _, name := range names {
+		base.CreateTeam(name, []models.Player{e.CreatePlayer(name, nil)}, nil)
+	}
+	cup, err := tournament.Wrap(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < rounds; i++ {
+		round, err := cup.NextRound()
+		if cup.GetStatus() == models.Status_COMPLETED {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, game := range round.GetGames() {
+			if len(game.GetTeams()) > 1 {
+				game.SetScores([]int64{3, 1})
+			}
+			game.SetFinal()
+		}
+		round.SetFinal()
+	}
+	return cup
+}
+
+var fourTeams = []string{"Ants", "Bees", "Cats", "Dogs"}
+
+func TestTournamentText(t *testing.T) {
+	tests := []struct {
+		name           string
+		tournamentType models.TournamentType
+		teams          []string
+		rounds         int
+		expected       string
+	}{
+		{"Single elimination", models.TournamentType_SINGLE_ELIMINATION, fourTeams, 3, `Cup
+═══
+
+Main
+Round 1       Round 2       Winner
+┌──────────┐
+│ ▶ Ants 3 │
+│   Dogs 1 │  ┌──────────┐
+└──────────┘  │ ▶ Ants 3 │  ┌──────┐
+              │   Cats 1 │  │ Ants │
+┌──────────┐  └──────────┘  └──────┘
+│ ▶ Cats 3 │
+│   Bees 1 │
+└──────────┘
+`},
+		{"Winner isn't shown before the final", models.TournamentType_SINGLE_ELIMINATION, fourTeams, 1, `Cup
+═══
+
+Main
+Round 1
+┌──────────┐
+│ ▶ Ants 3 │
+│   Dogs 1 │
+└──────────┘
+
+┌──────────┐
+│ ▶ Cats 3 │
+│   Bees 1 │
+└──────────┘
+`},
+		{"Double elimination with a play-in", models.TournamentType_DOUBLE_ELIMINATION, append(fourTeams, "Eels"), 10, `Cup
+═══
+
+Winning Bracket
+Round 1       Round 2       Round 3
+              ┌──────────┐
+              │ ▶ Ants 3 │
+┌──────────┐  │   Dogs 1 │  ┌──────────┐
+│ ▶ Dogs 3 │  └──────────┘  │ ▶ Ants 3 │
+│   Eels 1 │                │   Cats 1 │
+└──────────┘  ┌──────────┐  └──────────┘
+              │ ▶ Cats 3 │
+              │   Bees 1 │
+              └──────────┘
+
+Losing Bracket
+Round 3       Round 4
+┌──────────┐  ┌──────────┐
+│ ▶ Dogs 3 │  │ ▶ Dogs 3 │
+│   Bees 1 │  │   Cats 1 │
+└──────────┘  └──────────┘
+
+Finals
+Round 5       Winner
+┌──────────┐  ┌──────┐
+│ ▶ Ants 3 │  │ Ants │
+│   Dogs 1 │  └──────┘
+└──────────┘
+`},
+		{"Bye", models.TournamentType_ROUND_ROBIN, fourTeams[:3], 1, `Cup
+═══
+
+Round 1
+┌──────────┐
+│ ▶ Ants 3 │
+│   Bees 1 │
+└──────────┘
+
+┌──────────┐
+│ ▶ Cats 0 │
+│   BYE    │
+└──────────┘
+`},
+	}
+	for _, test := range tests {
+		out, err := GenerateTournamentText(playTournament(t, test.tournamentType, test.teams, test.rounds))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != test.expected {
+			t.Errorf("%s drawn as\n%s\nexpected\n%s", test.name, out, test.expected)
+		}
+	}
+}
@@ -48,6 +48,10 @@ func (c *CompassDraw) GetBracketOrder() []string {
 	return CompassDivisionNames
 }
 
+func (c *CompassDraw) DependsOnResults() bool {
+	return true
+}
+
 func (c *CompassDraw) GetActiveStage() models.Tournament {
 	return c
 }
@@ -58,12 +62,19 @@ func (c *CompassDraw) StartRound() {
 }
 
 func (c *CompassDraw) NextRound() (models.Round, error) {
-	lastRound := c.GetActiveRound()
+	return recordRound(c.Tournament, func(base models.Tournament) (models.Round, error) {
+		scoped := *c
+		scoped.Tournament = base
+		return scoped.nextRound()
+	})
+}
+
+func (c *CompassDraw) nextRound() (models.Round, error) {
+	rounds := c.GetAllRounds()
+	lastRound := c.replay(rounds)
 
 	var teams = make([][]models.Team, len(CompassDivisionNames))
 	gameSize := int(c.Tournament.GetGameSize())
-	moveForward := c.Tournament.GetAdvancing()
-	rounds := c.GetAllRounds()
 
 	if len(rounds) == 0 || lastRound == nil {
 		//Create first round
@@ -76,24 +87,8 @@ func (c *CompassDraw) NextRound() (models.Round, error) {
 		if len(rounds) >= len(compassDivisions) {
 			return nil, fmt.Errorf("All matches played")
 		}
-		roundCount := len(rounds)
-
-		for _, game := range lastRound.GetGames() {
-			gameTeams := game.GetTeams()
-			divChange := compassDivisions[roundCount][1]
-			teamSlice := make([]TeamScore, len(gameTeams))
-			for i, teamPlaced := range game.GetPlaces() {
-				teamSlice[i] = TeamScore{gameTeams[i], int(teamPlaced)}
-			}
-			sort.Slice(teamSlice, BasicTeamScoreLess(teamSlice))
-
-			// Teams that lost need to move down a division
-			for _, teamPlaced := range teamSlice[moveForward:] {
-				c.divisionAssignments[teamPlaced.Team.GetName()] += divChange
-			}
-
-		}
-		for _, t := range c.GetTeams() {
+		c.moveDivisions(lastRound, len(rounds))
+		for _, t := range withoutWithdrawn(c.GetTeams()) {
 			teams[c.divisionAssignments[t.GetName()]] = append(teams[c.divisionAssignments[t.GetName()]], t)
 		}
 	}
@@ -145,3 +140,35 @@ func (c *CompassDraw) NextRound() (models.Round, error) {
 
 	return r, nil
 }
+
+// moveDivisions moves the teams that lost in the round down a division, roundCount is how many rounds have been played so far
+func (c *CompassDraw) moveDivisions(round models.Round, roundCount int) {
+	moveForward := c.Tournament.GetAdvancing()
+	for _, game := range round.GetGames() {
+		gameTeams := game.GetTeams()
+		divChange := compassDivisions[roundCount][1]
+		teamSlice := make([]TeamScore, len(gameTeams))
+		for i, teamPlaced := range game.GetPlaces() {
+			teamSlice[i] = TeamScore{gameTeams[i], int(teamPlaced)}
+		}
+		sort.Slice(teamSlice, BasicTeamScoreLess(teamSlice))
+
+		// Teams that lost need to move down a division
+		for _, teamPlaced := range teamSlice[moveForward:] {
+			c.divisionAssignments[teamPlaced.Team.GetName()] += divChange
+		}
+
+	}
+}
+
+// replay rebuilds the division assignments from the rounds that have already been created, except for the most recent one. Returns the most recent round
+func (c *CompassDraw) replay(rounds []models.Round) models.Round {
+	c.divisionAssignments = map[string]int{}
+	if len(rounds) == 0 {
+		return nil
+	}
+	for i, r := range rounds[:len(rounds)-1] {
+		c.moveDivisions(r, i+1)
+	}
+	return rounds[len(rounds)-1]
+}
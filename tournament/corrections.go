@@ -0,0 +1,121 @@
+package tournament
+
+import (
+	"fmt"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// CorrectGame changes the result of a completed game in the tournament. Formats that make later rounds from results, like elimination and Swiss,
+// have the rounds after the game's round removed, and the next round is created again from the corrected result. Rounds that have already been
+// played are only removed if replay is set, otherwise ErrLaterRounds is returned and nothing is changed. Other formats keep all of their rounds.
+// Returns the regenerated round, or nil if no round needed to be regenerated
+func CorrectGame(t models.Tournament, game models.Game, official string, scores []int64, places []int64, replay bool) (models.Round, error) {
+	if game.GetStatus() != models.Status_COMPLETED {
+		return nil, fmt.Errorf("Game isn't final, set its result instead of correcting it")
+	}
+	if !t.DependsOnResults() {
+		return nil, game.Correct(official, scores, places)
+	}
+	if group, ok := t.(*GroupCompetition); ok { // Only the group the game was played in depends on it
+		for _, child := range group.children {
+			if index, _ := roundOf(child.GetAllRounds(), game.GetId()); index >= 0 {
+				return CorrectGame(child, game, official, scores, places, replay)
+			}
+		}
+		return nil, fmt.Errorf("Game isn't part of tournament %s", t.GetName())
+	}
+
+	// The correction and the rounds it removes and creates again are a single action, so they are undone together and none of it is kept if any of it fails
+	var regenerated models.Round
+	err := t.Record("CorrectGame", func(base models.Tournament) error {
+		scoped, err := Wrap(base)
+		if err != nil {
+			return err
+		}
+		regenerated, err = correctGame(scoped, game.GetId(), official, scores, places, replay)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return regenerated, nil
+}
+
+// correctGame is CorrectGame for formats that depend on results, with the game given by its id
+func correctGame(t models.Tournament, gameId uint64, official string, scores []int64, places []int64, replay bool) (models.Round, error) {
+	rounds := t.GetAllRounds()
+	index, game := roundOf(rounds, gameId)
+	if index < 0 {
+		return nil, fmt.Errorf("Game isn't part of tournament %s", t.GetName())
+	}
+	later := rounds[index+1:]
+	if !replay {
+		for _, r := range later {
+			if roundPlayed(r) {
+				return nil, models.ErrLaterRounds
+			}
+		}
+	}
+
+	err := game.Correct(official, scores, places)
+	if err != nil {
+		return nil, err
+	}
+	for range later {
+		err = t.RemoveLastRound()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to remove rounds that depend on the game: %w", err)
+		}
+	}
+
+	if len(later) == 0 && t.GetStatus() != models.Status_COMPLETED {
+		return nil, nil
+	}
+
+	// A corrected final can change who won, so completed tournaments get another chance to create a round
+	wasCompleted := len(later) == 0
+	t.SetStatus(models.Status_ONGOING)
+	r, err := t.NextRound()
+	if err != nil {
+		if wasCompleted { // No more rounds are needed, the tournament is still over
+			t.SetStatus(models.Status_COMPLETED)
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r, nil
+}
+
+// roundOf returns the index of the round a game was played in, including games played as part of a series, along with the game.
+// -1 if it isn't in any of the rounds
+func roundOf(rounds []models.Round, gameId uint64) (int, models.Game) {
+	for i, r := range rounds {
+		for _, g := range r.GetGames() {
+			if g.GetId() == gameId {
+				return i, g
+			}
+			if series := g.GetSeries(); series != nil {
+				for _, sub := range series.GetGames() {
+					if sub.GetId() == gameId {
+						return i, sub
+					}
+				}
+			}
+		}
+	}
+	return -1, nil
+}
+
+// roundPlayed determines if a round has been started, or any game in it between teams has been
+func roundPlayed(r models.Round) bool {
+	if r.GetStatus() != models.Status_NEW {
+		return true
+	}
+	for _, g := range r.GetGames() {
+		if len(g.GetTeams()) > 1 && g.GetStatus() != models.Status_NEW {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package tournament_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+func TestCorrectRoundRobinKeepsRounds(t *testing.T) {
+	_, tourney := newTournament(t, models.TournamentType_ROUND_ROBIN, 4, 2, 1)
+	for i := 0; i < 3; i++ {
+		round, err := tourney.NextRound()
+		if err != nil {
+			t.Fatal(err)
+		}
+		playRound(round)
+	}
+
+	game := tourney.GetAllRounds()[0].GetGames()[0]
+	regenerated, err := tournament.CorrectGame(tourney, game, "Ref", nil, []int64{1, 0}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regenerated != nil {
+		t.Errorf("Correcting a round robin game created a round")
+	}
+	rounds := tourney.GetAllRounds()
+	if len(rounds) != 3 {
+		t.Fatalf("%d rounds after the correction, expected 3", len(rounds))
+	}
+	for i, r := range rounds {
+		if r.GetStatus() != models.Status_COMPLETED {
+			t.Errorf("Round %d is no longer completed", i+1)
+		}
+	}
+	if places := rounds[0].GetGames()[0].GetPlaces(); places[0] != 1 || places[1] != 0 {
+		t.Errorf("Places after the correction are %v, expected [1 0]", places)
+	}
+}
+
+func TestCorrectEliminationNeedsReplay(t *testing.T) {
+	_, tourney := newTournament(t, models.TournamentType_SINGLE_ELIMINATION, 4, 2, 1)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	playRound(round)
+	final, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	game := tourney.GetAllRounds()[0].GetGames()[0]
+	loser := game.GetTeams()[1]
+	if _, err := tournament.CorrectGame(tourney, game, "Ref", nil, []int64{1, 0}, false); err != nil {
+		t.Fatalf("Correcting before the final was played: %v", err)
+	}
+	rounds := tourney.GetAllRounds()
+	if len(rounds) != 2 || !hasTeam(rounds[1], loser) {
+		t.Fatalf("Final wasn't created again with %s", loser.GetName())
+	}
+
+	playRound(rounds[1])
+	final = tourney.GetAllRounds()[1]
+	game = tourney.GetAllRounds()[0].GetGames()[0]
+	_, err = tournament.CorrectGame(tourney, game, "Ref", nil, []int64{0, 1}, false)
+	if !errors.Is(err, models.ErrLaterRounds) {
+		t.Fatalf("Expected ErrLaterRounds once the final was played, got %v", err)
+	}
+	if places := tourney.GetAllRounds()[0].GetGames()[0].GetPlaces(); places[0] != 1 {
+		t.Errorf("Refused correction changed the game to %v", places)
+	}
+	if final.GetStatus() != models.Status_COMPLETED || len(tourney.GetAllRounds()) != 2 {
+		t.Errorf("Refused correction removed the final")
+	}
+
+	if _, err := tournament.CorrectGame(tourney, game, "Ref", nil, []int64{0, 1}, true); err != nil {
+		t.Fatal(err)
+	}
+	rounds = tourney.GetAllRounds()
+	if len(rounds) != 2 || hasTeam(rounds[1], loser) || rounds[1].GetStatus() == models.Status_COMPLETED {
+		t.Errorf("Replayed correction didn't make the final again")
+	}
+}
+
+func TestUndoCorrection(t *testing.T) {
+	c, tourney := newTournament(t, models.TournamentType_SINGLE_ELIMINATION, 4, 2, 1)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	playRound(round)
+	if _, err := tourney.NextRound(); err != nil {
+		t.Fatal(err)
+	}
+
+	game := tourney.GetAllRounds()[0].GetGames()[0]
+	winner, loser := game.GetTeams()[0], game.GetTeams()[1]
+	if _, err := tournament.CorrectGame(tourney, game, "Ref", nil, []int64{1, 0}, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// A single undo reverts the correction along with the final it made again
+	if err := c.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	rounds := tourney.GetAllRounds()
+	if len(rounds) != 2 {
+		t.Fatalf("%d rounds after undoing the correction, expected 2", len(rounds))
+	}
+	if places := rounds[0].GetGames()[0].GetPlaces(); places[0] != 0 || places[1] != 1 {
+		t.Errorf("Places after undoing the correction are %v, expected [0 1]", places)
+	}
+	if !hasTeam(rounds[1], winner) || hasTeam(rounds[1], loser) {
+		t.Errorf("Final after undoing the correction isn't the one the original result made")
+	}
+	if corrections := rounds[0].GetGames()[0].GetCorrections(); len(corrections) != 0 {
+		t.Errorf("Undone correction is still recorded")
+	}
+}
+
+// hasTeam determines if the team plays in the round
+func hasTeam(r models.Round, team models.Team) bool {
+	for _, g := range r.GetGames() {
+		for _, t := range g.GetTeams() {
+			if t.Equals(team) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -19,9 +19,11 @@ const (
 	noL                         // No teams in the Losers bracket queue
 	final                       // Play the final round
 	finalExtra                  // Play an extra final round (The team from the losers bracket won, so now each team has one loss)
+	qualify                     // Play-in round for the lowest seeds, before the first round
 )
 
-// DoubleElimination fulfills the Tournament interface. Provides the logic for running a Tournament of a Double Elimination type. Commonly used as a conclusion of a season or competition
+// DoubleElimination fulfills the Tournament interface. Provides the logic for running a Tournament of a Double Elimination type. Commonly used as a conclusion of a season or competition.
+// Teams that lose a play-in game are out straight away, the Loser's Bracket is only for teams that made it into the bracket
 type DoubleElimination struct {
 	models.Tournament
 	gameCounter  int
@@ -35,18 +37,18 @@ type DoubleElimination struct {
 func NewDoubleElimination(baseTournament models.Tournament) models.Tournament {
 	teams := baseTournament.GetTeams()
 
-	tmp := math.Log2(float64(len(teams)))
-	var playInGames int
-	if tmp != math.Floor(tmp) {
-		playInGames = len(teams) - int(math.Pow(math.Floor(tmp), 2.0))
-	}
-	return &DoubleElimination{baseTournament, 0, playInGames, map[uint32]int{}, 0, []models.Team{}, []models.Team{}}
+	playInTeams, _, _ := getPlayIn(len(teams), baseTournament.GetGameSize(), baseTournament.GetAdvancing()) // NextRound reports brackets that can't be filled
+	return &DoubleElimination{baseTournament, 0, playInTeams, map[uint32]int{}, 0, []models.Team{}, []models.Team{}}
 }
 
 func (d *DoubleElimination) GetBracketOrder() []string {
 	return doubleEliminationBrackets
 }
 
+func (d *DoubleElimination) DependsOnResults() bool {
+	return true
+}
+
 func (d *DoubleElimination) GetActiveStage() models.Tournament {
 	return d
 }
@@ -63,30 +65,153 @@ func (c *DoubleElimination) StartRound() {
 	lastRound.SetStatus(models.Status_ONGOING)
 }
 
-func getTeamCountToInclude(teamCount int, gameSize uint32) int {
-	tmp := math.Log2(float64(teamCount))
-	return int(math.Pow(float64(gameSize), math.Floor(tmp)))
+func (c *DoubleElimination) NextRound() (models.Round, error) {
+	return recordRound(c.Tournament, func(base models.Tournament) (models.Round, error) {
+		scoped := *c
+		scoped.Tournament = base
+		return scoped.nextRound()
+	})
 }
 
-func (c *DoubleElimination) NextRound() (models.Round, error) {
-	lastRound := c.GetActiveRound()
+func (c *DoubleElimination) nextRound() (models.Round, error) {
+	rounds := c.GetAllRounds()
+	if len(rounds) == 0 {
+		if _, _, err := getPlayIn(len(c.GetTeams()), c.GetGameSize(), c.GetAdvancing()); err != nil {
+			return nil, err
+		}
+	}
+	lastRound, err := c.replay(rounds)
+	if err != nil {
+		return nil, err
+	}
 
-	var winningTeams, losingTeams []models.Team
 	gameSize := int(c.Tournament.GetGameSize())
 	moveForward := c.GetAdvancing()
+	winningTeams, losingTeams, err := c.plan(lastRound)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := c.Tournament.NextRound()
+	if err != nil {
+		return r, err
+	}
+	r.SetStatus(models.Status_NEW)
+
+	if c.roundType == qualify {
+		_, gameCount, _ := getPlayIn(len(c.GetTeams()), c.GetGameSize(), moveForward)
+		for _, gameTeams := range snakeTeams(winningTeams, gameCount) {
+			game := r.CreateGame(gameTeams, c.IsScored())
+			game.SetBracket(doubleEliminationBrackets[0])
+		}
+
+	} else if c.roundType == final {
+		game := r.CreateGame(winningTeams, c.IsScored())
+		game.SetBracket(doubleEliminationBrackets[2])
 
-	if len(c.GetAllRounds()) == 0 || lastRound == nil {
+	} else {
+		half := gameSize / 2
+		if len(winningTeams) > int(c.GetAdvancing()) {
+			//avoidRematches(winningTeams, int(gameSize))
+			gameCount := int(math.Ceil(float64(len(winningTeams)) / float64(gameSize)))
+			shortGames := gameCount*gameSize - len(winningTeams)
+			if len(winningTeams) < gameSize {
+				shortGames = 1
+			}
+			for i := 0; i < gameCount-shortGames; i++ {
+				game := r.CreateGame(winningTeams[i*gameSize:(i+1)*gameSize], c.IsScored())
+				game.SetBracket(doubleEliminationBrackets[0])
+
+			}
+			place := (gameCount - shortGames) * gameSize
+			for i := gameCount - shortGames; i < (gameCount - 1); i++ {
+				game := r.CreateGame(winningTeams[place:place+gameSize-1], c.IsScored())
+				game.SetBracket(doubleEliminationBrackets[0])
+
+			}
+			if shortGames > 0 {
+				game := r.CreateGame(winningTeams[place:], c.IsScored())
+				game.SetBracket(doubleEliminationBrackets[0])
+			}
+
+		}
+
+		if c.roundType == lMinor { //after first round, we should "shuffle" the teams up so that the people that just dropped from the winning round aren't playing each other
+			if len(losingTeams) > 0 && len(c.GetAllRounds())-c.playInRounds() != 2 {
+				halfTeamPoint := len(losingTeams) / 2
+				for i, team := range losingTeams[:halfTeamPoint] {
+					if i%2 == 1 { //"shuffle" every other
+						continue
+					}
+					team2 := losingTeams[i+halfTeamPoint]
+					losingTeams[i], losingTeams[i+halfTeamPoint] = team2, team
+				}
+			}
+		}
+
+		if len(losingTeams) > half { // Check if there are enough teams to play the losers bracket
+			//avoidRematches(losingTeams, int(gameSize))
+			gameCount := int(math.Ceil(float64(len(losingTeams)) / float64(gameSize)))
+			shortGames := gameCount*gameSize - len(losingTeams)
+			for i := 0; i < gameCount-shortGames; i++ {
+				game := r.CreateGame(losingTeams[i*gameSize:(i+1)*gameSize], c.IsScored())
+				game.SetBracket(doubleEliminationBrackets[1])
+			}
+			place := (gameCount - shortGames) * gameSize
+			for i := gameCount - shortGames; i < gameCount; i++ {
+				shortTeams := gameSize - 1
+				if i < 0 {
+					shortTeams--
+					i++
+				}
+				game := r.CreateGame(losingTeams[place:place+shortTeams], c.IsScored())
+				game.SetBracket(doubleEliminationBrackets[1])
+				place += shortTeams
+			}
+		}
+
+	}
+
+	return r, nil
+}
+
+// plan takes the results of the last round, and moves teams between the queues to work out who plays in the next round
+func (c *DoubleElimination) plan(lastRound models.Round) (winningTeams, losingTeams []models.Team, err error) {
+	gameSize := int(c.Tournament.GetGameSize())
+	moveForward := c.GetAdvancing()
+
+	if lastRound == nil {
 		//Create first round
 		winningTeams = c.GetTeams()
+		c.playIn, _, _ = getPlayIn(len(winningTeams), uint32(gameSize), moveForward)
+
+		if c.playIn > 0 {
+			// The lowest seeds play their way into the bracket, everyone else waits for the first round
+			c.roundType = qualify
+			c.winnerQue = append(c.winnerQue, winningTeams[:len(winningTeams)-c.playIn]...)
+			winningTeams = winningTeams[len(winningTeams)-c.playIn:]
+		} else if c.IsSeeded() {
+			winningTeams = seedBracket(winningTeams, gameSize)
 
-		idealTeamNum := int(math.Pow(2.0, math.Ceil(math.Log2(float64(len(winningTeams))))))
-		for i := len(winningTeams); i < idealTeamNum; i++ {
-			winningTeams = append(winningTeams, nil)
 		}
-		if c.IsSeeded() {
-			winningTeams = seed(winningTeams)
+	} else if c.roundType == qualify {
+		if lastRound.GetStatus() != models.Status_COMPLETED {
+			return nil, nil, fmt.Errorf("Can't start new round until previous round is completed")
+		}
 
+		// Play-in winners join the bracket, losers are eliminated without dropping to the Loser's Bracket
+		for _, game := range lastRound.GetGames() {
+			teamSlice := placedTeams(game)
+			for _, teamPlaced := range teamSlice[:moveForward] {
+				c.winnerQue = append(c.winnerQue, teamPlaced.Team)
+			}
+		}
+		winningTeams = c.winnerQue
+		c.winnerQue = []models.Team{}
+		if c.IsSeeded() {
+			winningTeams = seedBracket(winningTeams, gameSize)
 		}
+		c.roundType = first
 	} else {
 
 		if c.roundType == first {
@@ -94,26 +219,12 @@ func (c *DoubleElimination) NextRound() (models.Round, error) {
 		}
 
 		if lastRound.GetStatus() != models.Status_COMPLETED {
-			return nil, fmt.Errorf("Can't start new round until previous round is completed")
+			return nil, nil, fmt.Errorf("Can't start new round until previous round is completed")
 		}
 
 		losingQue := []models.Team{}
 		for _, game := range lastRound.GetGames() {
-			teams := game.GetTeams()
-			places := game.GetPlaces()
-
-			teamSlice := make([]TeamScore, 0)
-			for i, teamPlaced := range places {
-				if len(teams) <= i {
-					break
-				}
-				if models.IsByeTeam(teams[i]) {
-					continue
-				}
-				teamSlice = append(teamSlice, TeamScore{teams[i], int(teamPlaced)})
-			}
-
-			sort.Slice(teamSlice, BasicTeamScoreLess(teamSlice))
+			teamSlice := placedTeams(game)
 
 			switch game.GetBracket() {
 			case doubleEliminationBrackets[0]: //Winner's Bracket
@@ -134,13 +245,11 @@ func (c *DoubleElimination) NextRound() (models.Round, error) {
 				bracket := prevGame.GetBracket()
 				switch bracket {
 				case doubleEliminationBrackets[0]: // Winner of the Winner's Bracket won the final round (only one game needed)
-					fmt.Println("GAME OVER", winner.GetName(), "has won")
 					c.SetStatus(models.Status_COMPLETED)
-					return nil, fmt.Errorf("Too many rounds @ %d", len(c.GetAllRounds()))
+					return nil, nil, fmt.Errorf("Too many rounds @ %d", len(c.GetAllRounds()))
 				case doubleEliminationBrackets[1]: // Winner of the Loser's Bracket one, second final round will be needed
 					// Need second final round
 					c.roundType = finalExtra
-					fmt.Println("Need second final round")
 					for _, teamPlaced := range teamSlice[:moveForward] {
 						c.winnerQue = append(c.winnerQue, teamPlaced.Team)
 					}
@@ -148,9 +257,8 @@ func (c *DoubleElimination) NextRound() (models.Round, error) {
 						losingQue = append(losingQue, teamPlaced.Team)
 					}
 				case doubleEliminationBrackets[2]: // The second Final Round was played, winner of it is the overall winner
-					fmt.Println("GAME OVER", winner.GetName(), "has won")
 					c.SetStatus(models.Status_COMPLETED)
-					return nil, fmt.Errorf("Too many rounds @ %d", len(c.GetAllRounds()))
+					return nil, nil, fmt.Errorf("Too many rounds @ %d", len(c.GetAllRounds()))
 
 				}
 			}
@@ -158,136 +266,88 @@ func (c *DoubleElimination) NextRound() (models.Round, error) {
 		}
 
 		c.losersQue = append(c.losersQue, losingQue...)
+		c.losersQue = withoutWithdrawn(c.losersQue) // Withdrawn teams don't get a second chance
 
-		rounds := c.GetAllRounds()
-
-		if len(rounds) < 1 {
+		switch c.roundType {
+		case first, noL, lMinor:
+			c.roundType = lMajor
+		case lMajor:
+			c.roundType = lMinor
+		}
+		if len(c.losersQue) == 0 {
+			c.roundType = noL
+		}
 
-			switch len(rounds) {
-			case 0:
-				winningTeams = c.winnerQue
-				c.winnerQue = []models.Team{}
-			case 1: // Handle losers play-in game
-				losersSize := len(c.losersQue)
-				for i := 0; i < c.playIn; i++ {
-					losingTeams = append(losingTeams, c.losersQue[i])
-					losingTeams = append(losingTeams, c.losersQue[losersSize-1-i])
-				}
-				c.losersQue = c.losersQue[c.playIn:]
-				c.losersQue = c.losersQue[:losersSize-1-c.playIn]
-			}
+		if len(c.losersQue) > gameSize/2 {
+			losingTeams = c.losersQue
+			c.losersQue = []models.Team{}
 		} else {
-			switch c.roundType {
-			case first, noL, lMinor:
-				c.roundType = lMajor
-			case lMajor:
-				c.roundType = lMinor
-			}
-			if len(c.losersQue) == 0 {
-				c.roundType = noL
-			}
-
-			if len(c.losersQue) > gameSize/2 {
-				losingTeams = c.losersQue
-				c.losersQue = []models.Team{}
-			} else {
-				// How many teams should I pull?
-			}
+			// How many teams should I pull?
+		}
 
-			gameSize := c.GetGameSize()
-			if len(c.winnerQue) > int(c.GetAdvancing()) {
-				winningTeams = c.winnerQue
-				c.winnerQue = []models.Team{}
-			}
+		gameSize := c.GetGameSize()
+		if len(c.winnerQue) > int(c.GetAdvancing()) {
+			winningTeams = c.winnerQue
+			c.winnerQue = []models.Team{}
+		}
 
-			// Figure out if we need to move to the Final round
-			if (len(winningTeams) == 0 && len(losingTeams) == 0) &&
-				((len(c.winnerQue) <= int(c.GetAdvancing()) && len(c.losersQue) <= int(c.GetAdvancing())) ||
-					(len(c.winnerQue) == 0 && len(c.losersQue) == int(gameSize))) {
-
-				c.roundType = final
-			}
+		// Figure out if we need to move to the Final round
+		if (len(winningTeams) == 0 && len(losingTeams) == 0) &&
+			((len(c.winnerQue) <= int(c.GetAdvancing()) && len(c.losersQue) <= int(c.GetAdvancing())) ||
+				(len(c.winnerQue) == 0 && len(c.losersQue) == int(gameSize))) {
 
+			c.roundType = final
+			winningTeams = append(winningTeams, c.winnerQue...)
+			winningTeams = append(winningTeams, c.losersQue...)
+			c.winnerQue = []models.Team{}
+			c.losersQue = []models.Team{}
 		}
-	}
 
-	r, err := c.Tournament.NextRound()
-	if err != nil {
-		return r, err
 	}
-	r.SetStatus(models.Status_NEW)
 
-	if c.roundType == final {
-		teams := []models.Team{}
-		teams = append(teams, c.winnerQue...)
-		teams = append(teams, c.losersQue...)
-		c.winnerQue = []models.Team{}
-		c.losersQue = []models.Team{}
-		game := r.CreateGame(teams, c.IsScored())
-		game.SetBracket(doubleEliminationBrackets[2])
+	return winningTeams, losingTeams, nil
+}
 
-	} else {
-		half := gameSize / 2
-		if len(winningTeams) > int(c.GetAdvancing()) {
-			//avoidRematches(winningTeams, int(gameSize))
-			gameCount := int(math.Ceil(float64(len(winningTeams)) / float64(gameSize)))
-			shortGames := gameCount*gameSize - len(winningTeams)
-			if len(winningTeams) < gameSize {
-				shortGames = 1
-			}
-			for i := 0; i < gameCount-shortGames; i++ {
-				game := r.CreateGame(winningTeams[i*gameSize:(i+1)*gameSize], c.IsScored())
-				game.SetBracket(doubleEliminationBrackets[0])
+// replay rebuilds the queues by planning every round that has already been created, so rounds that were removed or corrected are accounted for. Returns the most recent round
+func (c *DoubleElimination) replay(rounds []models.Round) (models.Round, error) {
+	c.roundType = first
+	c.winnerQue = []models.Team{}
+	c.losersQue = []models.Team{}
 
-			}
-			place := (gameCount - shortGames) * gameSize
-			for i := gameCount - shortGames; i < (gameCount - 1); i++ {
-				game := r.CreateGame(winningTeams[place:place+gameSize-1], c.IsScored())
-				game.SetBracket(doubleEliminationBrackets[0])
+	var lastRound models.Round
+	for i, r := range rounds {
+		if _, _, err := c.plan(lastRound); err != nil {
+			return nil, fmt.Errorf("Unable to replay round %d: %w", i, err)
+		}
+		lastRound = r
+	}
+	return lastRound, nil
+}
 
-			}
-			if shortGames > 0 {
-				game := r.CreateGame(winningTeams[place:], c.IsScored())
-				game.SetBracket(doubleEliminationBrackets[0])
-			}
+// playInRounds returns how many rounds were spent qualifying teams for the bracket
+func (c *DoubleElimination) playInRounds() int {
+	if c.playIn > 0 {
+		return 1
+	}
+	return 0
+}
 
-		}
+// placedTeams returns the real teams of a game ordered by how they placed
+func placedTeams(game models.Game) []TeamScore {
+	teams := game.GetTeams()
+	places := game.GetPlaces()
 
-		if c.roundType == lMinor { //after first round, we should "shuffle" the teams up so that the people that just dropped from the winning round aren't playing each other
-			if len(losingTeams) > 0 && len(c.GetAllRounds()) != 2 {
-				halfTeamPoint := len(losingTeams) / 2
-				for i, team := range losingTeams[:halfTeamPoint] {
-					if i%2 == 1 { //"shuffle" every other
-						continue
-					}
-					team2 := losingTeams[i+halfTeamPoint]
-					losingTeams[i], losingTeams[i+halfTeamPoint] = team2, team
-				}
-			}
+	teamSlice := make([]TeamScore, 0)
+	for i, teamPlaced := range places {
+		if len(teams) <= i {
+			break
 		}
-
-		if len(losingTeams) > half { // Check if there are enough teams to play the losers bracket
-			//avoidRematches(losingTeams, int(gameSize))
-			gameCount := int(math.Ceil(float64(len(losingTeams)) / float64(gameSize)))
-			shortGames := gameCount*gameSize - len(losingTeams)
-			for i := 0; i < gameCount-shortGames; i++ {
-				game := r.CreateGame(losingTeams[i*gameSize:(i+1)*gameSize], c.IsScored())
-				game.SetBracket(doubleEliminationBrackets[1])
-			}
-			place := (gameCount - shortGames) * gameSize
-			for i := gameCount - shortGames; i < gameCount; i++ {
-				shortTeams := gameSize - 1
-				if i < 0 {
-					shortTeams--
-					i++
-				}
-				game := r.CreateGame(losingTeams[place:place+shortTeams], c.IsScored())
-				game.SetBracket(doubleEliminationBrackets[1])
-				place += shortTeams
-			}
+		if models.IsByeTeam(teams[i]) {
+			continue
 		}
-
+		teamSlice = append(teamSlice, TeamScore{teams[i], int(teamPlaced)})
 	}
 
-	return r, nil
+	sort.Slice(teamSlice, BasicTeamScoreLess(teamSlice))
+	return teamSlice
 }
@@ -0,0 +1,149 @@
+package tournament_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// newTournament creates a wrapped tournament of teamCount single player teams in a fresh database
+func newTournament(t *testing.T, tournamentType models.TournamentType, teamCount int, gameSize uint32, advancing uint32) (models.Competition, models.Tournament) {
+	engine := testutil.NewEngine(t)
+	c := engine.CreateCompetition("Test", nil)
+	base := c.AddTournament("Test", tournamentType, nil, false, gameSize, advancing, false)
+	for i := 1; i <= teamCount; i++ {
+		name := fmt.Sprintf("Team %d", i)
+		base.CreateTeam(name, []models.Player{engine.CreatePlayer(name, nil)}, nil)
+	}
+	wrapped, err := tournament.Wrap(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c, wrapped
+}
+
+// playRound finishes every game of the round, with the teams placing in the order they are listed
+func playRound(round models.Round) {
+	for _, game := range round.GetGames() {
+		places := []int64{}
+		for i := range game.GetTeams() {
+			places = append(places, int64(i))
+		}
+		game.SetPlaces(places)
+		game.SetFinal()
+	}
+	round.SetFinal()
+}
+
+func TestPlayInAdvancingTwo(t *testing.T) {
+	for _, tournamentType := range []models.TournamentType{models.TournamentType_SINGLE_ELIMINATION, models.TournamentType_DOUBLE_ELIMINATION} {
+		_, tourney := newTournament(t, tournamentType, 12, 4, 2)
+
+		round, err := tourney.NextRound()
+		if err != nil {
+			t.Fatal(err)
+		}
+		playIn := 0
+		for _, game := range round.GetGames() {
+			playIn += len(game.GetTeams())
+		}
+		if len(round.GetGames()) != 2 || playIn != 8 {
+			t.Fatalf("%v: %d teams in %d play-in games, expected 8 in 2", tournamentType, playIn, len(round.GetGames()))
+		}
+		playRound(round)
+
+		round, err = tourney.NextRound()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bracket := 0
+		for _, game := range round.GetGames() {
+			bracket += len(game.GetTeams())
+		}
+		if bracket != 8 {
+			t.Errorf("%v: %d teams in the bracket after the play-in, expected 8", tournamentType, bracket)
+		}
+	}
+}
+
+func TestPlayInCantFill(t *testing.T) {
+	for _, tournamentType := range []models.TournamentType{models.TournamentType_SINGLE_ELIMINATION, models.TournamentType_DOUBLE_ELIMINATION} {
+		_, tourney := newTournament(t, tournamentType, 20, 3, 2)
+		if _, err := tourney.NextRound(); err == nil {
+			t.Errorf("%v: expected an error for 20 teams in games of 3 with 2 advancing", tournamentType)
+		}
+	}
+}
+
+func TestUndoNextRound(t *testing.T) {
+	for _, tournamentType := range []models.TournamentType{models.TournamentType_SINGLE_ELIMINATION, models.TournamentType_DOUBLE_ELIMINATION, models.TournamentType_SWISS_FORMAT} {
+		c, tourney := newTournament(t, tournamentType, 6, 2, 1)
+		if _, err := tourney.NextRound(); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := c.Undo(); err != nil {
+			t.Fatal(err)
+		}
+		if rounds := tourney.GetAllRounds(); len(rounds) != 0 {
+			t.Errorf("%v tournament has %d rounds after undoing its first round", tournamentType, len(rounds))
+		}
+		for _, team := range tourney.GetTeams() {
+			if games := team.GetRecords(); len(games) != 0 {
+				t.Errorf("%v tournament still has %d games of %s after undoing its first round", tournamentType, len(games), team.GetName())
+			}
+		}
+	}
+}
+
+func TestDoubleEliminationPlayInLosersAreOut(t *testing.T) {
+	_, tourney := newTournament(t, models.TournamentType_DOUBLE_ELIMINATION, 6, 2, 1)
+	playIn, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var losers []models.Team
+	for _, game := range playIn.GetGames() {
+		losers = append(losers, game.GetTeams()[1])
+	}
+	playRound(playIn)
+
+	rounds := 1
+	for ; rounds < 20; rounds++ {
+		round, err := tourney.NextRound()
+		if err != nil {
+			break
+		}
+		for _, loser := range losers {
+			if hasTeam(round, loser) {
+				t.Errorf("%s lost in the play-in but plays in round %d", loser.GetName(), rounds+1)
+			}
+		}
+		playRound(round)
+	}
+	if tourney.GetStatus() != models.Status_COMPLETED {
+		t.Errorf("Tournament wasn't completed after %d rounds", rounds)
+	}
+}
+
+func TestDoubleEliminationReplayFails(t *testing.T) {
+	_, tourney := newTournament(t, models.TournamentType_DOUBLE_ELIMINATION, 8, 2, 1)
+	for i := 0; i < 2; i++ {
+		round, err := tourney.NextRound()
+		if err != nil {
+			t.Fatal(err)
+		}
+		playRound(round)
+	}
+
+	tourney.GetAllRounds()[0].SetStatus(models.Status_ONGOING)
+	if _, err := tourney.NextRound(); err == nil {
+		t.Errorf("Expected an error when an earlier round isn't completed")
+	}
+	if rounds := tourney.GetAllRounds(); len(rounds) != 2 {
+		t.Errorf("%d rounds after a round couldn't be replayed, expected 2", len(rounds))
+	}
+}
@@ -31,6 +31,15 @@ func (g *GroupCompetition) GetBracketOrder() []string {
 	return brackets
 }
 
+func (g *GroupCompetition) DependsOnResults() bool {
+	for _, child := range g.children {
+		if child.DependsOnResults() {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *GroupCompetition) StartRound() {
 	for _, child := range g.children {
 		lastRound := child.GetActiveRound()
@@ -40,6 +49,7 @@ func (g *GroupCompetition) StartRound() {
 	}
 }
 
+// NextRound creates the next round of every group, each group's round is a single action of its own
 func (g *GroupCompetition) NextRound() (models.Round, error) {
 
 	var grouped groupRound
@@ -56,15 +66,32 @@ func (g *GroupCompetition) NextRound() (models.Round, error) {
 			return nil, err
 		}
 
-		grouped.rounds = append(grouped.rounds, round)
+		grouped.add(child, round)
 
 	}
 	return &grouped, nil
 
 }
 
+// RemoveLastRound removes the most recent round from every group
+func (g *GroupCompetition) RemoveLastRound() error {
+	for _, child := range g.children {
+		err := child.RemoveLastRound()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type groupRound struct {
 	rounds []models.Round
+	groups []string // Name of the group each round belongs to
+}
+
+func (r *groupRound) add(group models.Tournament, round models.Round) {
+	r.rounds = append(r.rounds, round)
+	r.groups = append(r.groups, group.GetName())
 }
 
 func (r groupRound) CreateGame(teams []models.Team, scored bool) models.Game { //Don't actually support creating games through this, null operation
@@ -73,12 +100,28 @@ func (r groupRound) CreateGame(teams []models.Team, scored bool) models.Game { /
 
 func (r groupRound) GetGames() []models.Game {
 	var games []models.Game
-	for _, round := range r.rounds {
-		games = append(games, round.GetGames()...)
+	for i, round := range r.rounds {
+		for _, game := range round.GetGames() {
+			games = append(games, groupGame{game, r.groups[i]})
+		}
 	}
 	return games
 }
 
+// groupGame is a game of one of the groups, its bracket is named after the group so games from different groups can be told apart
+type groupGame struct {
+	models.Game
+	group string
+}
+
+func (g groupGame) GetBracket() string {
+	bracket := g.Game.GetBracket()
+	if strings.HasPrefix(bracket, g.group+":") { // Stored with the group's name
+		return bracket
+	}
+	return g.group + ":" + bracket
+}
+
 func (r groupRound) SetFinal() {
 	for _, round := range r.rounds {
 		round.SetFinal()
@@ -106,11 +149,37 @@ func (r groupRound) GetStatus() models.Status { // If there are any rounds, retu
 	return models.Status_NEW
 }
 
+func (r groupRound) SetSeriesLength(length uint32) {
+	for _, round := range r.rounds {
+		round.SetSeriesLength(length)
+	}
+}
+
+func (r groupRound) GetSeriesLength() uint32 {
+	for _, round := range r.rounds {
+		return round.GetSeriesLength()
+	}
+	return 0
+}
+
+func (r groupRound) Schedule(bookings []models.Booking) { // Games belong to the rounds of the groups, so they are booked one at a time
+	for _, b := range bookings {
+		b.Game.Schedule(b.Arena, b.Start, b.End)
+	}
+}
+
+func (r groupRound) AssignOfficials(assignments []models.Assignment) { // Games belong to the rounds of the groups, so officials are assigned one game at a time
+	for _, a := range assignments {
+		a.Game.AssignOfficial(a.Official, a.Role)
+	}
+}
+
 func (g *GroupCompetition) GetRounds() models.Round {
 	rounds := groupRound{}
 	for _, child := range g.children {
-		r := child.GetAllRounds()
-		rounds.rounds = append(rounds.rounds, r...)
+		for _, r := range child.GetAllRounds() {
+			rounds.add(child, r)
+		}
 	}
 	return rounds
 
@@ -119,26 +188,22 @@ func (g *GroupCompetition) GetRounds() models.Round {
 func (g *GroupCompetition) GetActiveRound() models.Round {
 	rounds := groupRound{}
 	for _, child := range g.children {
-		r := child.GetActiveRound()
-		rounds.rounds = append(rounds.rounds, r)
+		rounds.add(child, child.GetActiveRound())
 	}
 	return rounds
 }
 
 func (g *GroupCompetition) GetAllRounds() []models.Round {
-	allRounds := map[int][]models.Round{}
+	allRounds := map[int]*groupRound{}
 	rounds := []models.Round{}
 	maxRounds := 0
 	for _, child := range g.children {
 		r := child.GetAllRounds()
 		for i, round := range r {
-			allRounds[i] = append(allRounds[i], round)
-			for _, game := range round.GetGames() {
-				if strings.Contains(game.GetBracket(), child.GetName()) {
-					continue
-				}
-				game.SetBracket(child.GetName() + ":" + game.GetBracket())
+			if allRounds[i] == nil {
+				allRounds[i] = &groupRound{}
 			}
+			allRounds[i].add(child, round)
 		}
 		if len(r) > maxRounds {
 			maxRounds = len(r)
@@ -146,8 +211,7 @@ func (g *GroupCompetition) GetAllRounds() []models.Round {
 	}
 
 	for i := 0; i < maxRounds; i++ {
-		r := groupRound{allRounds[i]}
-		rounds = append(rounds, r)
+		rounds = append(rounds, *allRounds[i])
 	}
 
 	return rounds
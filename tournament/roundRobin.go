@@ -22,7 +22,20 @@ func (c *RoundRobin) GetBracketOrder() []string {
 	return []string{""}
 }
 
+// DependsOnResults is false, every round of a round robin is set by the schedule no matter who wins
+func (c *RoundRobin) DependsOnResults() bool {
+	return false
+}
+
 func (c *RoundRobin) Start() {
+	c.totalRounds = c.countRounds()
+
+	c.SetStatus(models.Status_ONGOING)
+
+}
+
+// countRounds works out how many rounds are needed for the teams currently registered
+func (c *RoundRobin) countRounds() int {
 	// Total rounds is decided by looking at how many other teams a team needs to play, and then how many of those teams can be played against each round.
 	// If all games in a round don't have the same amount of teams, then an extra round is needed
 	numTeams := len(c.GetTeams())
@@ -31,10 +44,7 @@ func (c *RoundRobin) Start() {
 	if (numTeams/gameSize)*gameSize != numTeams {
 		totalRounds++
 	}
-	c.totalRounds = totalRounds
-
-	c.SetStatus(models.Status_ONGOING)
-
+	return totalRounds
 }
 
 func (c *RoundRobin) StartRound() {
@@ -44,11 +54,22 @@ func (c *RoundRobin) StartRound() {
 }
 
 func (c *RoundRobin) NextRound() (models.Round, error) {
+	return recordRound(c.Tournament, func(base models.Tournament) (models.Round, error) {
+		scoped := *c
+		scoped.Tournament = base
+		r, err := scoped.nextRound()
+		c.totalRounds = scoped.totalRounds
+		return r, err
+	})
+}
+
+func (c *RoundRobin) nextRound() (models.Round, error) {
 
 	gameSize := int(c.Tournament.GetGameSize())
 	teams := c.GetTeams()
 
 	rounds := c.Tournament.GetAllRounds()
+	missed := map[int]int{}
 
 	if len(rounds) == 0 {
 		//Create first round
@@ -60,23 +81,104 @@ func (c *RoundRobin) NextRound() (models.Round, error) {
 			return nil, fmt.Errorf("Can't start new round until previous round is completed")
 		}
 
-		if len(rounds) >= c.totalRounds {
-			c.SetStatus(models.Status_COMPLETED)
-			return nil, fmt.Errorf("All matches played")
-		}
-
+		// Teams may have registered since the tournament started, so more rounds may be needed
+		c.totalRounds = c.countRounds()
+		missed = missedRounds(teams, len(rounds))
 	}
 
-	teamsSplit := avoidRematches(teams, gameSize)
+	var games [][]models.Team
+	if len(rounds) < c.totalRounds {
+		games = avoidRematches(teams, gameSize)
+	}
+	games = scheduleMakeups(teams, missed, gameSize, games)
+	if len(rounds) > 0 && len(games) == 0 {
+		c.SetStatus(models.Status_COMPLETED)
+		return nil, fmt.Errorf("All matches played")
+	}
 
 	r, err := c.Tournament.NextRound()
 	if err != nil {
 		return r, err
 	}
-
-	for _, t := range teamsSplit {
+	for _, t := range games {
 		r.CreateGame(t, c.IsScored())
 	}
 
 	return r, nil
 }
+
+// scheduleMakeups adds games to the round's scheduled games that pair late entries with teams they haven't played yet, one of the rounds they missed
+// at a time. Only teams without a game in the round, or with a bye, are used, so makeups wait for rounds with free teams, like the ones after the
+// regular schedule is over. Teams that get a makeup game instead of a bye have their bye removed
+func scheduleMakeups(teams []models.Team, missed map[int]int, gameSize int, scheduled [][]models.Team) [][]models.Team {
+	busy := map[string]bool{}
+	for _, game := range scheduled {
+		if len(game) < 2 {
+			continue
+		}
+		for _, t := range game {
+			busy[t.GetName()] = true
+		}
+	}
+
+	var makeups [][]models.Team
+	for i, team := range teams {
+		if missed[i] == 0 || busy[team.GetName()] {
+			continue
+		}
+		opponents := unplayedOpponents(team, teams, gameSize-1, busy)
+		if len(opponents) == 0 {
+			continue
+		}
+		game := append([]models.Team{team}, opponents...)
+		for _, t := range game {
+			busy[t.GetName()] = true
+		}
+		makeups = append(makeups, game)
+	}
+
+	games := [][]models.Team{}
+	for _, game := range scheduled {
+		if len(game) == 1 && busy[game[0].GetName()] {
+			continue
+		}
+		games = append(games, game)
+	}
+	return append(games, makeups...)
+}
+
+// missedRounds returns how many rounds each team, by index, has missed by joining the tournament late
+func missedRounds(teams []models.Team, played int) map[int]int {
+	missed := map[int]int{}
+	for i, team := range teams {
+		if team.IsWithdrawn() {
+			continue
+		}
+		if count := played - len(team.GetRecords()); count > 0 {
+			missed[i] = count
+		}
+	}
+	return missed
+}
+
+// unplayedOpponents returns up to count teams that team has not played against yet, leaving out the busy ones
+func unplayedOpponents(team models.Team, teams []models.Team, count int, busy map[string]bool) []models.Team {
+	played := map[string]bool{}
+	for _, game := range team.GetRecords() {
+		for _, opponent := range game.GetTeams() {
+			played[opponent.GetName()] = true
+		}
+	}
+
+	opponents := []models.Team{}
+	for _, opponent := range teams {
+		if len(opponents) == count {
+			break
+		}
+		if team.Equals(opponent) || opponent.IsWithdrawn() || played[opponent.GetName()] || busy[opponent.GetName()] {
+			continue
+		}
+		opponents = append(opponents, opponent)
+	}
+	return opponents
+}
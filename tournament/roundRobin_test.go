@@ -0,0 +1,49 @@
+package tournament_test
+
+import (
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+func TestLateEntryMakeups(t *testing.T) {
+	_, tourney := newTournament(t, models.TournamentType_ROUND_ROBIN, 4, 2, 1)
+	round, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	playRound(round)
+	late := tourney.CreateTeam("Late", tourney.GetTeams()[0].GetPlayers(), nil)
+
+	for i := 0; i < 20; i++ {
+		round, err = tourney.NextRound()
+		if err != nil {
+			break
+		}
+		booked := map[string]bool{}
+		for _, game := range round.GetGames() {
+			for _, team := range game.GetTeams() {
+				if booked[team.GetName()] {
+					t.Errorf("%s has more than one game in round %d", team.GetName(), i+2)
+				}
+				booked[team.GetName()] = true
+			}
+		}
+		playRound(round)
+	}
+	if err == nil {
+		t.Fatal("Tournament didn't finish")
+	}
+
+	opponents := map[string]bool{}
+	for _, game := range late.GetRecords() {
+		for _, team := range game.GetTeams() {
+			if !team.Equals(late) {
+				opponents[team.GetName()] = true
+			}
+		}
+	}
+	if len(opponents) != 4 {
+		t.Errorf("Late entry played %v, expected every other team", opponents)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/justinjudd/competition/models"
 )
 
+var singleEliminationBrackets = []string{"Main"}
+
 // SingleElimination fulfills the Tournament interface. Provides the logic for running a Tournament of a Single Elimination type. Commonly used as a conclusion of a season or competition
 type SingleElimination struct {
 	models.Tournament
@@ -18,7 +20,11 @@ func NewSingleElimination(name string, teams []models.Team, seeded bool, gameSiz
 }
 
 func (s *SingleElimination) GetBracketOrder() []string {
-	return []string{"Main"}
+	return singleEliminationBrackets
+}
+
+func (s *SingleElimination) DependsOnResults() bool {
+	return true
 }
 
 func (s *SingleElimination) GetActiveStage() models.Tournament {
@@ -35,18 +41,32 @@ func (s *SingleElimination) StartRound() {
 }
 
 func (s *SingleElimination) NextRound() (models.Round, error) {
+	return recordRound(s.Tournament, func(base models.Tournament) (models.Round, error) {
+		return (&SingleElimination{base}).nextRound()
+	})
+}
+
+func (s *SingleElimination) nextRound() (models.Round, error) {
 
 	var teams []models.Team
 	gameSize := int(s.Tournament.GetGameSize())
 	moveForward := s.Tournament.GetAdvancing()
 	rounds := s.GetAllRounds()
+	playInTeams, playInGames, err := getPlayIn(len(s.GetTeams()), uint32(gameSize), moveForward)
+	if err != nil {
+		return nil, err
+	}
 	if len(rounds) == 0 {
 		//Create first round
 		teams = s.GetTeams()
+		if playInTeams > 0 {
+			// The lowest seeds qualify for the bracket in a play-in round
+			return s.playInRound(teams[len(teams)-playInTeams:], playInGames)
+		}
 		old := make([]models.Team, len(teams))
 		copy(old, teams)
 		if s.Tournament.IsSeeded() {
-			teams = seed(teams)
+			teams = seedBracket(teams, gameSize)
 		}
 		for _, t := range old {
 			if t == nil {
@@ -72,6 +92,11 @@ func (s *SingleElimination) NextRound() (models.Round, error) {
 		if lastRound.GetStatus() != models.Status_COMPLETED {
 			return nil, fmt.Errorf("Can't start new round until previous round is completed")
 		}
+		if len(rounds) == 1 && playInTeams > 0 {
+			// Teams that skipped the play-in round are joined by the play-in winners
+			allTeams := s.GetTeams()
+			teams = append(teams, allTeams[:len(allTeams)-playInTeams]...)
+		}
 		for _, game := range lastRound.GetGames() {
 			gameTeams := game.GetTeams()
 			teamSlice := make([]TeamScore, len(gameTeams))
@@ -84,6 +109,9 @@ func (s *SingleElimination) NextRound() (models.Round, error) {
 			}
 
 		}
+		if len(rounds) == 1 && playInTeams > 0 && s.Tournament.IsSeeded() {
+			teams = seedBracket(teams, gameSize)
+		}
 	}
 
 	if len(teams) < gameSize {
@@ -101,7 +129,23 @@ func (s *SingleElimination) NextRound() (models.Round, error) {
 	}
 	r.SetStatus(models.Status_NEW)
 	for i := 0; i < len(teams)/gameSize; i++ {
-		r.CreateGame(teams[i*gameSize:(i+1)*gameSize], s.IsScored())
+		game := r.CreateGame(teams[i*gameSize:(i+1)*gameSize], s.IsScored())
+		game.SetBracket(singleEliminationBrackets[0])
+	}
+
+	return r, nil
+}
+
+// playInRound creates the qualifying round for the lowest seeds. Their winners fill out the rest of the bracket in the next round
+func (s *SingleElimination) playInRound(teams []models.Team, gameCount int) (models.Round, error) {
+	r, err := s.Tournament.NextRound()
+	if err != nil {
+		return r, err
+	}
+	r.SetStatus(models.Status_NEW)
+	for _, gameTeams := range snakeTeams(teams, gameCount) {
+		game := r.CreateGame(gameTeams, s.IsScored())
+		game.SetBracket(singleEliminationBrackets[0])
 	}
 
 	return r, nil
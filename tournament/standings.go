@@ -0,0 +1,120 @@
+package tournament
+
+import (
+	"sort"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// Standing is a team's overall record within a tournament
+type Standing struct {
+	Team          models.Team
+	Played        int
+	Wins          int
+	Losses        int
+	Draws         int
+	Forfeits      int // Games the team forfeited
+	Disqualified  int // Games the team was disqualified from
+	Walkovers     int // Games won because every opponent forfeited or was disqualified
+	PointsFor     int64
+	PointsAgainst int64
+	Withdrawn     bool
+	HeadToHead    int // Wins less losses against the teams level with this one on wins and draws
+}
+
+// Standings tallies the record of every team from the completed games of the tournament. Teams are ordered by wins (including walkovers), then draws,
+// then head-to-head record against the teams they are level with, then point differential.
+// Withdrawn teams are listed last
+func Standings(t models.Tournament) []Standing {
+	standings := []Standing{}
+	index := map[string]int{}
+	for _, team := range t.GetTeams() {
+		if models.IsByeTeam(team) {
+			continue
+		}
+		index[team.GetName()] = len(standings)
+		standings = append(standings, Standing{Team: team, Withdrawn: team.IsWithdrawn()})
+	}
+
+	for _, round := range t.GetAllRounds() {
+		for _, game := range round.GetGames() {
+			if game.GetStatus() != models.Status_COMPLETED {
+				continue
+			}
+			teams := game.GetTeams()
+			results := game.GetResults()
+			scores := game.GetScores()
+			var total int64
+			for _, score := range scores {
+				total += score
+			}
+			for i, team := range teams {
+				n, ok := index[team.GetName()]
+				if !ok || i >= len(results) {
+					continue
+				}
+				standing := &standings[n]
+				standing.Played++
+				switch results[i] {
+				case models.Result_WIN:
+					standing.Wins++
+				case models.Result_LOSS:
+					standing.Losses++
+				case models.Result_DRAW:
+					standing.Draws++
+				case models.Result_FORFEIT:
+					standing.Forfeits++
+				case models.Result_DISQUALIFIED:
+					standing.Disqualified++
+				case models.Result_WALKOVER:
+					standing.Walkovers++
+				}
+				if t.IsScored() && i < len(scores) {
+					standing.PointsFor += scores[i]
+					standing.PointsAgainst += total - scores[i]
+				}
+			}
+		}
+	}
+
+	level := func(a Standing, b Standing) bool {
+		return a.Withdrawn == b.Withdrawn && a.Wins+a.Walkovers == b.Wins+b.Walkovers && a.Draws == b.Draws
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Withdrawn != b.Withdrawn {
+			return !a.Withdrawn
+		}
+		if a.Wins+a.Walkovers != b.Wins+b.Walkovers {
+			return a.Wins+a.Walkovers > b.Wins+b.Walkovers
+		}
+		return a.Draws > b.Draws
+	})
+
+	// Teams that are level are separated by the games they played against each other, as a mini league, then by point differential
+	for start := 0; start < len(standings); {
+		end := start + 1
+		for end < len(standings) && level(standings[start], standings[end]) {
+			end++
+		}
+		tied := standings[start:end]
+		for i := range tied {
+			for j := range tied {
+				if i != j {
+					m := models.HeadToHead(tied[i].Team, tied[j].Team)
+					tied[i].HeadToHead += m.Wins - m.Losses
+				}
+			}
+		}
+		sort.SliceStable(tied, func(i, j int) bool {
+			a, b := tied[i], tied[j]
+			if a.HeadToHead != b.HeadToHead {
+				return a.HeadToHead > b.HeadToHead
+			}
+			return a.PointsFor-a.PointsAgainst > b.PointsFor-b.PointsAgainst
+		})
+		start = end
+	}
+
+	return standings
+}
@@ -0,0 +1,264 @@
+package tournament
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// Swiss fulfills the Tournament interface, and provides the logic for tournaments where each round teams play others on the same score that they haven't played yet.
+// Games are between two teams, and the team listed first in a game plays first, like white in chess. Teams are given the first and second spots evenly
+type Swiss struct {
+	models.Tournament
+}
+
+// NewSwiss creates and returns a new Swiss Tournament that uses the provided base tournament StorageEngine
+func NewSwiss(baseTournament models.Tournament) models.Tournament {
+	return &Swiss{baseTournament}
+}
+
+func (s *Swiss) GetBracketOrder() []string {
+	return []string{""}
+}
+
+func (s *Swiss) DependsOnResults() bool {
+	return true
+}
+
+func (s *Swiss) Start() {
+	s.SetStatus(models.Status_ONGOING)
+}
+
+func (s *Swiss) StartRound() {
+	round := s.Tournament.GetActiveRound()
+	round.Start()
+}
+
+// countRounds returns the rounds to be played, which are enough to separate the teams currently registered unless the tournament sets how many rounds are played
+func (s *Swiss) countRounds() int {
+	if count := s.GetRoundCount(); count > 0 {
+		return int(count)
+	}
+	teams := len(swissTeams(s.GetTeams()))
+	if teams < 2 {
+		return 1
+	}
+	return int(math.Ceil(math.Log2(float64(teams))))
+}
+
+func (s *Swiss) NextRound() (models.Round, error) {
+	return recordRound(s.Tournament, func(base models.Tournament) (models.Round, error) {
+		return (&Swiss{base}).nextRound()
+	})
+}
+
+func (s *Swiss) nextRound() (models.Round, error) {
+	if s.GetGameSize() != 2 {
+		return nil, fmt.Errorf("Swiss tournaments are played in games of 2 teams")
+	}
+
+	rounds := s.Tournament.GetAllRounds()
+	if len(rounds) == 0 {
+		//Create first round
+		s.Start()
+	} else {
+		lastRound := s.Tournament.GetActiveRound()
+		if lastRound.GetStatus() != models.Status_COMPLETED {
+			return nil, fmt.Errorf("Can't start new round until previous round is completed")
+		}
+		if len(rounds) >= s.countRounds() {
+			s.SetStatus(models.Status_COMPLETED)
+			return nil, fmt.Errorf("All rounds played")
+		}
+	}
+
+	// Teams that registered late join on no points
+	records := map[string]swissRecord{}
+	teams := swissTeams(s.GetTeams())
+	for _, team := range teams {
+		records[team.GetName()] = newSwissRecord(team)
+	}
+	if len(teams) < 2 {
+		s.SetStatus(models.Status_COMPLETED)
+		return nil, fmt.Errorf("Not enough teams for another round")
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		return records[teams[i].GetName()].points > records[teams[j].GetName()].points
+	})
+
+	// The lowest placed team that hasn't had a bye sits out
+	var bye models.Team
+	if len(teams)%2 == 1 {
+		out := len(teams) - 1
+		for i := len(teams) - 1; i >= 0; i-- {
+			if records[teams[i].GetName()].byes == 0 {
+				out = i
+				break
+			}
+		}
+		bye = teams[out]
+		teams = append(teams[:out:out], teams[out+1:]...)
+	}
+
+	pairs, ok := pairSwiss(teams, records, false)
+	if !ok {
+		pairs, _ = pairSwiss(teams, records, true) // Every pairing has a rematch, so rematches are allowed
+	}
+
+	r, err := s.Tournament.NextRound()
+	if err != nil {
+		return r, err
+	}
+	for _, pair := range pairs {
+		first, second := pair[0], pair[1]
+		if records[second.GetName()].firstDue(records[first.GetName()], len(rounds)) {
+			first, second = second, first
+		}
+		r.CreateGame([]models.Team{first, second}, s.IsScored())
+	}
+	if bye != nil {
+		r.CreateGame([]models.Team{bye}, s.IsScored())
+	}
+	return r, nil
+}
+
+// swissTeams returns the teams that are still being paired
+func swissTeams(teams []models.Team) []models.Team {
+	paired := []models.Team{}
+	for _, team := range teams {
+		if models.IsByeTeam(team) || team.IsWithdrawn() {
+			continue
+		}
+		paired = append(paired, team)
+	}
+	return paired
+}
+
+// swissRecord is what a team has done so far, for pairing it
+type swissRecord struct {
+	points   int             // 2 for each win, 1 for each draw
+	byes     int             // Rounds the team sat out with a win
+	balance  int             // Games played first less games played second
+	lastSpot int             // 1 if the team played first in its last game with an opponent, 2 if it played second, 0 if it hasn't played one
+	played   map[string]bool // Opponents the team has played
+}
+
+func newSwissRecord(team models.Team) swissRecord {
+	record := swissRecord{played: map[string]bool{}}
+	for _, game := range team.GetRecords() {
+		teams := game.GetTeams()
+		for i, opponent := range teams {
+			if team.Equals(opponent) || models.IsByeTeam(opponent) {
+				continue
+			}
+			record.played[opponent.GetName()] = true
+			if i == 1 { // The team is listed before its opponent
+				record.balance++
+				record.lastSpot = 1
+			} else {
+				record.balance--
+				record.lastSpot = 2
+			}
+		}
+		if game.GetStatus() != models.Status_COMPLETED {
+			continue
+		}
+		result, _ := game.GetTeamResult(team)
+		switch result {
+		case models.Result_WIN, models.Result_WALKOVER:
+			record.points += 2
+		case models.Result_DRAW:
+			record.points++
+		}
+		if len(teams) == 1 && result == models.Result_WALKOVER {
+			record.byes++
+		}
+	}
+	return record
+}
+
+// firstDue determines if the team should play first against the other team. The team that has played first less often goes first,
+// then the team that played second most recently. Otherwise the other team, which is placed higher, alternates each round
+func (r swissRecord) firstDue(other swissRecord, round int) bool {
+	if r.balance != other.balance {
+		return r.balance < other.balance
+	}
+	if r.lastSpot != other.lastSpot {
+		return r.lastSpot == 2 || other.lastSpot == 1
+	}
+	return round%2 == 1
+}
+
+// pairSwiss pairs off the teams, which are ordered by points. The top team of each score group is paired with the team half way down the group,
+// moving on to lower groups if none of its own group are left. Returns false if the teams can't all be paired without rematches
+func pairSwiss(teams []models.Team, records map[string]swissRecord, rematches bool) ([][2]models.Team, bool) {
+	p := swissPairer{teams: teams, records: records, rematches: rematches, failed: map[string]bool{}}
+	remaining := make([]int, len(teams))
+	for i := range remaining {
+		remaining[i] = i
+	}
+	return p.pair(remaining)
+}
+
+// swissPairer pairs teams by their index, remembering which sets of teams couldn't be paired so no set is tried twice
+type swissPairer struct {
+	teams     []models.Team
+	records   map[string]swissRecord
+	rematches bool
+	failed    map[string]bool // Sets of unpaired teams that can't all be paired
+}
+
+func (p *swissPairer) pair(remaining []int) ([][2]models.Team, bool) {
+	if len(remaining) == 0 {
+		return nil, true
+	}
+	key := p.key(remaining)
+	if p.failed[key] {
+		return nil, false
+	}
+
+	top, rest := p.teams[remaining[0]], remaining[1:]
+	points := p.records[top.GetName()].points
+	group := 0
+	for group < len(rest) && p.records[p.teams[rest[group]].GetName()].points == points {
+		group++
+	}
+	half := 0
+	if group > 0 {
+		half = (group+1)/2 - 1
+	}
+	order := []int{}
+	for i := half; i < group; i++ {
+		order = append(order, i)
+	}
+	for i := 0; i < half; i++ {
+		order = append(order, i)
+	}
+	for i := group; i < len(rest); i++ {
+		order = append(order, i)
+	}
+
+	for _, i := range order {
+		opponent := p.teams[rest[i]]
+		if !p.rematches && p.records[top.GetName()].played[opponent.GetName()] {
+			continue
+		}
+		others := append(append([]int{}, rest[:i]...), rest[i+1:]...)
+		if pairs, ok := p.pair(others); ok {
+			return append([][2]models.Team{{top, opponent}}, pairs...), true
+		}
+	}
+	p.failed[key] = true
+	return nil, false
+}
+
+// key identifies a set of teams, as a bit for each team
+func (p *swissPairer) key(remaining []int) string {
+	set := make([]byte, (len(p.teams)+7)/8)
+	for _, i := range remaining {
+		set[i/8] |= 1 << uint(i%8)
+	}
+	return string(set)
+}
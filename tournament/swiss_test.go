@@ -0,0 +1,68 @@
+package tournament
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// namedTeam is a team that is only known by its name, enough for pairing
+type namedTeam struct {
+	models.Team
+	name string
+}
+
+func (t namedTeam) GetName() string {
+	return t.name
+}
+
+func TestPairSwissUnpairable(t *testing.T) {
+	// The last 3 teams have played everyone else, so one of them is always left without an opponent they haven't played
+	teams := []models.Team{}
+	records := map[string]swissRecord{}
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("Team %d", i)
+		teams = append(teams, namedTeam{name: name})
+		records[name] = swissRecord{played: map[string]bool{}}
+	}
+	for i := 17; i < 20; i++ {
+		for j := 0; j < 17; j++ {
+			records[teams[i].GetName()].played[teams[j].GetName()] = true
+			records[teams[j].GetName()].played[teams[i].GetName()] = true
+		}
+	}
+
+	if _, ok := pairSwiss(teams, records, false); ok {
+		t.Errorf("Paired teams without rematches")
+	}
+	pairs, ok := pairSwiss(teams, records, true)
+	if !ok || len(pairs) != 10 {
+		t.Errorf("Paired %d games with rematches, expected 10", len(pairs))
+	}
+}
+
+func TestPairSwissAvoidsRematches(t *testing.T) {
+	teams := []models.Team{}
+	records := map[string]swissRecord{}
+	for i := 0; i < 6; i++ {
+		name := fmt.Sprintf("Team %d", i)
+		teams = append(teams, namedTeam{name: name})
+		records[name] = swissRecord{played: map[string]bool{}}
+	}
+	for _, pair := range [][2]int{{0, 3}, {1, 4}, {2, 5}} {
+		a, b := teams[pair[0]].GetName(), teams[pair[1]].GetName()
+		records[a].played[b] = true
+		records[b].played[a] = true
+	}
+
+	pairs, ok := pairSwiss(teams, records, false)
+	if !ok || len(pairs) != 3 {
+		t.Fatalf("Paired %d games, expected 3", len(pairs))
+	}
+	for _, pair := range pairs {
+		if records[pair[0].GetName()].played[pair[1].GetName()] {
+			t.Errorf("%s and %s were paired again", pair[0].GetName(), pair[1].GetName())
+		}
+	}
+}
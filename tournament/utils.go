@@ -1,6 +1,7 @@
 package tournament
 
 import (
+	"fmt"
 	"math"
 
 	"github.com/justinjudd/competition/models"
@@ -90,6 +91,73 @@ func seed(teams []models.Team) []models.Team {
 
 }
 
+// seedBracket orders teams for the first round of a bracket. Brackets with 2 team games use the standard seeding, larger games snake the seeds across the games
+func seedBracket(teams []models.Team, gameSize int) []models.Team {
+	if gameSize == 2 {
+		return seed(teams)
+	}
+	gameCount := int(math.Ceil(float64(len(teams)) / float64(gameSize)))
+	ordered := []models.Team{}
+	for _, game := range snakeTeams(teams, gameCount) {
+		ordered = append(ordered, game...)
+	}
+	return ordered
+}
+
+// snakeTeams splits the seeded teams across gameCount games, snaking back and forth so that the best teams play the worst teams
+func snakeTeams(teams []models.Team, gameCount int) [][]models.Team {
+	games := make([][]models.Team, gameCount)
+	for i, team := range teams {
+		game := i % gameCount
+		if (i/gameCount)%2 == 1 {
+			game = gameCount - 1 - game
+		}
+		games[game] = append(games[game], team)
+	}
+	return games
+}
+
+// getTeamCountToInclude returns the largest bracket size that can be filled by teamCount teams. A bracket is sized so that every round is made of
+// full games, so it grows from a single game by the number of games the advancing teams of each round fill
+func getTeamCountToInclude(teamCount int, gameSize uint32, advancing uint32) int {
+	if gameSize < 2 || advancing < 1 || advancing >= gameSize || teamCount < int(gameSize) {
+		return teamCount
+	}
+	size := int(gameSize)
+	for size%int(advancing) == 0 && size/int(advancing)*int(gameSize) <= teamCount {
+		size = size / int(advancing) * int(gameSize)
+	}
+	return size
+}
+
+// getPlayIn returns how many of the lowest seeded teams need to play in a qualifying round, and how many games they will play, so that the rest of the bracket is full.
+// Returns an error if there aren't enough teams to fill the play-in games
+func getPlayIn(teamCount int, gameSize uint32, advancing uint32) (teams int, games int, err error) {
+	excess := teamCount - getTeamCountToInclude(teamCount, gameSize, advancing)
+	if excess == 0 {
+		return 0, 0, nil
+	}
+	eliminated := int(gameSize) - int(advancing) // Teams knocked out by each play-in game
+	games = int(math.Ceil(float64(excess) / float64(eliminated)))
+	teams = excess + games*int(advancing)
+	if teams > teamCount {
+		return 0, 0, fmt.Errorf("%d teams can't fill a bracket of games of %d with %d advancing", teamCount, gameSize, advancing)
+	}
+	return teams, games, nil
+}
+
+// withoutWithdrawn returns the teams that are still playing in the tournament
+func withoutWithdrawn(teams []models.Team) []models.Team {
+	active := []models.Team{}
+	for _, team := range teams {
+		if team != nil && team.IsWithdrawn() {
+			continue
+		}
+		active = append(active, team)
+	}
+	return active
+}
+
 type TeamScore struct {
 	Team  models.Team
 	Score int
@@ -136,7 +204,7 @@ func avoidRematches(teams []models.Team, groupSize int) [][]models.Team {
 		splits[i] = index
 	}
 	for i := gameCount - shortGames; i < gameCount; i++ {
-		index += gameCount - 1
+		index += groupSize - 1
 		splits[i] = index
 	}
 	if splits[gameCount-1] > len(teams) { // This should be an error, the last splits should be the same as the number of teams
@@ -225,3 +293,18 @@ func FlipTies(place int) int {
 	}
 	return (place - 1) * -1
 }
+
+// recordRound creates the next round of a format as a single action of its base tournament, so undoing it removes the round along with its games.
+// next creates the round through the copy of base it is given. Formats that have run out of rounds mark the tournament completed and fail, that is kept
+func recordRound(base models.Tournament, next func(base models.Tournament) (models.Round, error)) (models.Round, error) {
+	var r models.Round
+	var roundErr error
+	err := base.Record("NextRound", func(base models.Tournament) error {
+		r, roundErr = next(base)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, roundErr
+}
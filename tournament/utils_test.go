@@ -0,0 +1,49 @@
+package tournament
+
+import "testing"
+
+func TestGetPlayIn(t *testing.T) {
+	tests := []struct {
+		teams, gameSize, advancing int
+		bracket, playIn, games     int
+		fails                      bool
+	}{
+		{teams: 8, gameSize: 2, advancing: 1, bracket: 8},
+		{teams: 10, gameSize: 2, advancing: 1, bracket: 8, playIn: 4, games: 2},
+		{teams: 3, gameSize: 2, advancing: 1, bracket: 2, playIn: 2, games: 1},
+		{teams: 16, gameSize: 4, advancing: 1, bracket: 16},
+		{teams: 20, gameSize: 4, advancing: 1, bracket: 16, playIn: 6, games: 2},
+		{teams: 8, gameSize: 4, advancing: 2, bracket: 8},
+		{teams: 12, gameSize: 4, advancing: 2, bracket: 8, playIn: 8, games: 2},
+		{teams: 16, gameSize: 4, advancing: 2, bracket: 16},
+		{teams: 20, gameSize: 4, advancing: 2, bracket: 16, playIn: 8, games: 2},
+		{teams: 27, gameSize: 6, advancing: 3, bracket: 24, playIn: 6, games: 1},
+		{teams: 30, gameSize: 6, advancing: 4, bracket: 6, fails: true},
+		{teams: 4, gameSize: 3, advancing: 2, bracket: 3, playIn: 3, games: 1},
+		{teams: 20, gameSize: 3, advancing: 2, bracket: 3, fails: true},
+		{teams: 3, gameSize: 4, advancing: 2, bracket: 3},
+	}
+	for _, test := range tests {
+		bracket := getTeamCountToInclude(test.teams, uint32(test.gameSize), uint32(test.advancing))
+		if bracket != test.bracket {
+			t.Errorf("%d teams in games of %d with %d advancing: bracket of %d, expected %d", test.teams, test.gameSize, test.advancing, bracket, test.bracket)
+		}
+		playIn, games, err := getPlayIn(test.teams, uint32(test.gameSize), uint32(test.advancing))
+		if test.fails {
+			if err == nil {
+				t.Errorf("%d teams in games of %d with %d advancing: expected an error, got %d teams in %d games", test.teams, test.gameSize, test.advancing, playIn, games)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d teams in games of %d with %d advancing: %v", test.teams, test.gameSize, test.advancing, err)
+			continue
+		}
+		if playIn != test.playIn || games != test.games {
+			t.Errorf("%d teams in games of %d with %d advancing: %d teams in %d play-in games, expected %d in %d", test.teams, test.gameSize, test.advancing, playIn, games, test.playIn, test.games)
+		}
+		if playIn > test.teams {
+			t.Errorf("%d teams in games of %d with %d advancing: %d teams play in", test.teams, test.gameSize, test.advancing, playIn)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package tournament
+
+import (
+	"fmt"
+
+	"github.com/justinjudd/competition/models"
+)
+
+// Wrap returns the tournament format matching the type of a tournament from a StorageEngine, so its rounds can be created.
+// Formats rebuild their state from the rounds that have already been stored, so a tournament can be wrapped again at any time, like on every request to a server
+func Wrap(baseTournament models.Tournament) (models.Tournament, error) {
+	switch baseTournament.GetType() {
+	case models.TournamentType_SINGLE_ELIMINATION:
+		return NewSingleElimination(baseTournament.GetName(), nil, baseTournament.IsSeeded(), baseTournament.GetGameSize(), baseTournament.GetAdvancing(), baseTournament.IsScored(), baseTournament), nil
+	case models.TournamentType_DOUBLE_ELIMINATION:
+		return NewDoubleElimination(baseTournament), nil
+	case models.TournamentType_ROUND_ROBIN:
+		return NewRoundRobin(baseTournament), nil
+	case models.TournamentType_COMPASS_DRAW:
+		return NewCompassDraw(baseTournament), nil
+	case models.TournamentType_SWISS_FORMAT:
+		return NewSwiss(baseTournament), nil
+	}
+	return nil, fmt.Errorf("Unsupported tournament type: %d", baseTournament.GetType())
+}
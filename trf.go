@@ -0,0 +1,439 @@
+package competition
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justinjudd/competition/models"
+	"github.com/justinjudd/competition/tournament"
+)
+
+// Tournaments are written as FIDE Tournament Report Files (TRF16), the fixed column text files chess federations take results in. Each team is a player,
+// numbered by the order it was added, and each round of a player's line is its opponent, its color and how the game ended.
+// The first team of a game plays white. Byes are games without an opponent: a walkover is a full point bye, a draw is a half point bye
+// and a forfeit is a zero point bye
+
+var trfResults = map[models.Result]byte{
+	models.Result_WIN:          '1',
+	models.Result_LOSS:         '0',
+	models.Result_DRAW:         '=',
+	models.Result_WALKOVER:     '+',
+	models.Result_FORFEIT:      '-',
+	models.Result_DISQUALIFIED: '-',
+}
+
+var trfTypes = map[models.TournamentType]string{
+	models.TournamentType_SINGLE_ELIMINATION: "Knockout",
+	models.TournamentType_DOUBLE_ELIMINATION: "Double Knockout",
+	models.TournamentType_ROUND_ROBIN:        "Round Robin",
+	models.TournamentType_COMPASS_DRAW:       "Compass Draw",
+	models.TournamentType_SWISS_FORMAT:       "Swiss System",
+	models.TournamentType_GROUP_PLAY:         "Group Play",
+}
+
+// trfGame is a player's game in a round, in the columns of a player line
+type trfGame struct {
+	opponent int  // Starting rank of the opponent, 0 for a bye
+	color    byte // 'w', 'b' or '-'
+	result   byte // ' ' while the game is being played
+}
+
+func (g trfGame) String() string {
+	return fmt.Sprintf("%04d %c %c", g.opponent, g.color, g.result)
+}
+
+// ExportTRF writes a tournament of two player games as a Tournament Report File, with a line for each player in the order they were added.
+// Points count a win as 1 and a draw as a half, and players are ranked by points then by the tournament's standings
+func ExportTRF(t models.Tournament) ([]byte, error) {
+	if t.GetGameSize() != 2 {
+		return nil, fmt.Errorf("Only tournaments of two team games can be written as TRF")
+	}
+
+	var players []models.Team
+	ranks := map[string]int{} // Starting rank of each player
+	for _, team := range t.GetTeams() {
+		if models.IsByeTeam(team) {
+			continue
+		}
+		if len(team.GetPlayers()) != 1 {
+			return nil, fmt.Errorf("%s has %d players, TRF is for tournaments of single players", team.GetName(), len(team.GetPlayers()))
+		}
+		players = append(players, team)
+		ranks[team.GetName()] = len(players)
+	}
+
+	rounds := t.GetAllRounds()
+	games := map[string][]*trfGame{} // Game of each player in each round
+	points := map[string]int{}       // In half points
+	var first, last time.Time
+	for r, round := range rounds {
+		for _, g := range round.GetGames() {
+			teams := g.GetTeams()
+			results := g.GetResults()
+			over := g.GetStatus() == models.Status_COMPLETED
+			if start, end := g.GetSchedule(); !start.IsZero() {
+				if first.IsZero() || start.Before(first) {
+					first = start
+				}
+				if end.After(last) {
+					last = end
+				}
+			}
+			for i, team := range teams {
+				if _, ok := ranks[team.GetName()]; !ok {
+					continue
+				}
+				game := &trfGame{color: '-', result: ' '}
+				if len(teams) == 2 {
+					game.opponent = ranks[teams[1-i].GetName()]
+					game.color = "wb"[i]
+				}
+				if over && i < len(results) {
+					game.result = trfResults[results[i]]
+					switch {
+					case game.opponent != 0:
+					case results[i] == models.Result_DRAW:
+						game.result = 'H'
+					case results[i] == models.Result_WIN || results[i] == models.Result_WALKOVER:
+						game.result = 'U'
+					default:
+						game.result = 'Z'
+					}
+					switch results[i] {
+					case models.Result_WIN, models.Result_WALKOVER:
+						points[team.GetName()] += 2
+					case models.Result_DRAW:
+						points[team.GetName()]++
+					}
+				}
+				if len(games[team.GetName()]) == 0 {
+					games[team.GetName()] = make([]*trfGame, len(rounds))
+				}
+				games[team.GetName()][r] = game
+			}
+		}
+	}
+
+	// Ranked by points, then by the standings of the tournament
+	standings := tournament.Standings(t)
+	order := map[string]int{}
+	for i, s := range standings {
+		order[s.Team.GetName()] = i
+	}
+	ranked := make([]models.Team, len(players))
+	copy(ranked, players)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].GetName(), ranked[j].GetName()
+		if points[a] != points[b] {
+			return points[a] > points[b]
+		}
+		return order[a] < order[b]
+	})
+	places := map[string]int{}
+	for i, team := range ranked {
+		places[team.GetName()] = i + 1
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "012 %s\n", t.GetName())
+	if !first.IsZero() {
+		fmt.Fprintf(&buf, "042 %s\n", first.Format("2006/01/02"))
+		fmt.Fprintf(&buf, "052 %s\n", last.Format("2006/01/02"))
+	}
+	fmt.Fprintf(&buf, "062 %d\n", len(players))
+	fmt.Fprintf(&buf, "092 %s\n", trfTypes[t.GetType()])
+	if count := t.GetRoundCount(); count > 0 {
+		fmt.Fprintf(&buf, "XXR %d\n", count)
+	}
+	for _, team := range players {
+		name := team.GetName()
+		line := fmt.Sprintf("001 %4d %1s%3s %-33.33s %4s %3s %11s %10s %4.1f %4d", ranks[name], "", "", team.GetPlayers()[0].GetName(), "", "", "", "", float64(points[name])/2, places[name])
+		for _, game := range games[name] {
+			if game == nil {
+				line += strings.Repeat(" ", 10)
+				continue
+			}
+			line += "  " + game.String()
+		}
+		buf.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// trfPlayer is a player line of a Tournament Report File
+type trfPlayer struct {
+	line  int // Line of the file, starting at 1
+	rank  int
+	name  string
+	games []trfGame // Zero where the player wasn't paired
+	team  models.Team
+}
+
+// ImportTRF adds the Swiss tournament of a Tournament Report File to a competition, with a team for each player and the rounds played so far.
+// Players that already exist are used where the names match. Games without a result are left to be played, so the tournament can carry on
+// pairing once they are over. Lines other than the tournament name (012), the number of rounds (XXR) and the players (001) are ignored
+func ImportTRF(engine models.StorageEngine, c models.Competition, data []byte) (models.Tournament, error) {
+	var name string
+	var roundCount int
+	var players []*trfPlayer
+	byRank := map[int]*trfPlayer{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		if len(line) < 3 {
+			continue
+		}
+		switch line[:3] {
+		case "012":
+			name = strings.TrimSpace(trfField(line, 4, len(line)))
+		case "XXR":
+			count, err := strconv.Atoi(strings.TrimSpace(trfField(line, 4, len(line))))
+			if err != nil || count < 1 {
+				return nil, fmt.Errorf("Line %d: Invalid number of rounds", number)
+			}
+			roundCount = count
+		case "001":
+			p, err := parseTRFPlayer(number, line)
+			if err != nil {
+				return nil, fmt.Errorf("Line %d: %w", number, err)
+			}
+			if byRank[p.rank] != nil {
+				return nil, fmt.Errorf("Line %d: Starting rank %d is already on line %d", number, p.rank, byRank[p.rank].line)
+			}
+			byRank[p.rank] = p
+			players = append(players, p)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Unable to read TRF: %w", err)
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("The file has no tournament name")
+	}
+	for _, t := range c.GetAllTournaments() {
+		if t.GetName() == name {
+			return nil, fmt.Errorf("%s already has a tournament named %q", c.GetName(), name)
+		}
+	}
+	if len(players) < 2 {
+		return nil, fmt.Errorf("The tournament needs at least 2 players")
+	}
+	rounds := 0
+	names := map[string]int{}
+	for _, p := range players {
+		if other, ok := names[p.name]; ok {
+			return nil, fmt.Errorf("Line %d: Player %q is already on line %d", p.line, p.name, other)
+		}
+		names[p.name] = p.line
+		if len(p.games) > rounds {
+			rounds = len(p.games)
+		}
+	}
+	if err := checkTRFGames(players, byRank); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(players, func(i, j int) bool { return players[i].rank < players[j].rank })
+	existing := map[string]models.Player{}
+	for _, p := range engine.GetPlayers() {
+		existing[p.GetName()] = p
+	}
+	base := c.AddTournament(name, models.TournamentType_SWISS_FORMAT, nil, true, 2, 1, false)
+	if roundCount > 0 {
+		base.SetRoundCount(uint32(roundCount))
+	}
+	for _, p := range players {
+		player, ok := existing[p.name]
+		if !ok {
+			player = engine.CreatePlayer(p.name, nil)
+		}
+		p.team = base.CreateTeam(p.name, []models.Player{player}, nil)
+	}
+	t, err := tournament.Wrap(base)
+	if err != nil {
+		return base, err
+	}
+
+	for r := 0; r < rounds; r++ {
+		round, err := base.NextRound()
+		if err != nil {
+			return t, fmt.Errorf("Unable to add round %d: %w", r+1, err)
+		}
+		over, played := true, false
+		for _, p := range players {
+			if r >= len(p.games) || p.games[r].color == 0 {
+				continue // Not paired this round
+			}
+			game := p.games[r]
+			var g models.Game
+			switch {
+			case game.opponent == 0:
+				g = round.CreateGame([]models.Team{p.team}, false)
+				switch game.result {
+				case 'H':
+					g.SetPlaces([]int64{-1})
+					g.SetFinal()
+				case 'Z', '-':
+					g.Forfeit(p.team, "Zero point bye")
+				case 'U', '+':
+					g.SetFinal()
+				}
+			case game.color == 'b' || (game.color == '-' && game.opponent < p.rank):
+				continue // Added with the opponent
+			default:
+				other := byRank[game.opponent]
+				g = round.CreateGame([]models.Team{p.team, other.team}, false)
+				applyTRFResult(g, p.team, game.result, other.team, other.games[r].result)
+			}
+			if g.GetStatus() == models.Status_COMPLETED {
+				played = true
+			} else {
+				over = false
+			}
+		}
+		switch {
+		case over:
+			round.SetFinal()
+		case played:
+			round.Start()
+		}
+	}
+
+	if rounds > 0 {
+		t.SetStatus(models.Status_ONGOING)
+	}
+	if roundCount > 0 && rounds >= roundCount && base.GetActiveRound().GetStatus() == models.Status_COMPLETED {
+		t.SetStatus(models.Status_COMPLETED)
+	}
+	return t, nil
+}
+
+// trfField returns the columns from start to end of a line, counting from 1 like the TRF specification. Columns past the end of the line are left out
+func trfField(line string, start int, end int) string {
+	if start > len(line) {
+		return ""
+	}
+	if end > len(line) {
+		end = len(line)
+	}
+	return line[start-1 : end]
+}
+
+// parseTRFPlayer reads a player line. Each round takes 10 columns from column 92: the opponent's starting rank, the color and the result
+func parseTRFPlayer(number int, line string) (*trfPlayer, error) {
+	rank, err := strconv.Atoi(strings.TrimSpace(trfField(line, 5, 8)))
+	if err != nil || rank < 1 {
+		return nil, fmt.Errorf("Invalid starting rank %q", trfField(line, 5, 8))
+	}
+	p := &trfPlayer{line: number, rank: rank, name: strings.TrimSpace(trfField(line, 15, 47))}
+	if p.name == "" {
+		return nil, fmt.Errorf("Missing player name")
+	}
+
+	for start := 92; start <= len(line); start += 10 {
+		block := trfField(line, start, start+7)
+		if strings.TrimSpace(block) == "" {
+			p.games = append(p.games, trfGame{})
+			continue
+		}
+		block += strings.Repeat(" ", 8-len(block))
+		round := len(p.games) + 1
+		opponent, err := strconv.Atoi(strings.TrimSpace(block[:4]))
+		if err != nil || opponent < 0 {
+			return nil, fmt.Errorf("Round %d: Invalid opponent %q", round, block[:4])
+		}
+		game := trfGame{opponent: opponent, color: block[5], result: block[7]}
+		switch game.result {
+		case 'W':
+			game.result = '1'
+		case 'D':
+			game.result = '='
+		case 'L':
+			game.result = '0'
+		case 'F':
+			game.result = 'U'
+		}
+		if !strings.ContainsRune("wb-", rune(game.color)) {
+			return nil, fmt.Errorf("Round %d: Invalid color %q", round, game.color)
+		}
+		valid := "10=+- "
+		if opponent == 0 {
+			valid = "HUZ+- "
+		}
+		if !strings.ContainsRune(valid, rune(game.result)) {
+			return nil, fmt.Errorf("Round %d: Invalid result %q", round, game.result)
+		}
+		if opponent == 0 && game.result == '-' && game.color == '-' {
+			game = trfGame{} // Not paired
+		}
+		p.games = append(p.games, game)
+	}
+	return p, nil
+}
+
+// trfOutcomes are the results the two players of a game can give it
+var trfOutcomes = map[[2]byte]bool{
+	{'1', '0'}: true,
+	{'0', '1'}: true,
+	{'=', '='}: true,
+	{'+', '-'}: true,
+	{'-', '+'}: true,
+	{'-', '-'}: true,
+	{' ', ' '}: true,
+}
+
+// checkTRFGames checks that both players of every game agree on it
+func checkTRFGames(players []*trfPlayer, byRank map[int]*trfPlayer) error {
+	for _, p := range players {
+		for r, game := range p.games {
+			if game.opponent == 0 {
+				continue
+			}
+			other, ok := byRank[game.opponent]
+			if !ok || game.opponent == p.rank {
+				return fmt.Errorf("Line %d: Round %d: Unknown opponent %d", p.line, r+1, game.opponent)
+			}
+			if r >= len(other.games) || other.games[r].opponent != p.rank {
+				return fmt.Errorf("Line %d: Round %d: %s isn't listed as playing %s", p.line, r+1, other.name, p.name)
+			}
+			otherGame := other.games[r]
+			colors := string([]byte{game.color, otherGame.color})
+			if colors != "wb" && colors != "bw" && colors != "--" {
+				return fmt.Errorf("Line %d: Round %d: %s and %s have colors %c and %c", p.line, r+1, p.name, other.name, game.color, otherGame.color)
+			}
+			if !trfOutcomes[[2]byte{game.result, otherGame.result}] {
+				return fmt.Errorf("Line %d: Round %d: %s and %s have results %c and %c", p.line, r+1, p.name, other.name, game.result, otherGame.result)
+			}
+		}
+	}
+	return nil
+}
+
+// applyTRFResult finishes a game between two players with their results. Games without results are left to be played
+func applyTRFResult(g models.Game, white models.Team, whiteResult byte, black models.Team, blackResult byte) {
+	switch whiteResult {
+	case '1':
+		g.SetPlaces([]int64{0, 1})
+		g.SetFinal()
+	case '0':
+		g.SetPlaces([]int64{1, 0})
+		g.SetFinal()
+	case '=':
+		g.SetPlaces([]int64{-1, -1})
+		g.SetFinal()
+	case '+':
+		g.Forfeit(black, "Forfeited")
+	case '-':
+		if blackResult == '-' {
+			g.ForfeitAll("Forfeited")
+			return
+		}
+		g.Forfeit(white, "Forfeited")
+	}
+}
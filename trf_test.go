@@ -0,0 +1,70 @@
+package competition
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/justinjudd/competition/internal/testutil"
+	"github.com/justinjudd/competition/models"
+)
+
+func TestTRFRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "spring_open.trf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := testutil.NewEngine(t)
+	tourney, err := ImportTRF(e, e.CreateCompetition("Chess", nil), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := ExportTRF(tourney)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(data) {
+		t.Errorf("Exported\n%s\nexpected\n%s", out, data)
+	}
+}
+
+func TestTRFContinuesPairing(t *testing.T) {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "spring_open.trf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := testutil.NewEngine(t)
+	tourney, err := ImportTRF(e, e.CreateCompetition("Chess", nil), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tourney.GetType() != models.TournamentType_SWISS_FORMAT || tourney.GetStatus() != models.Status_ONGOING {
+		t.Fatalf("Imported a %v tournament that is %v, expected an ongoing Swiss tournament", tourney.GetType(), tourney.GetStatus())
+	}
+
+	// Finish the round in progress, then the next one is paired from the imported results
+	round := tourney.GetActiveRound()
+	for _, game := range round.GetGames() {
+		if game.GetStatus() != models.Status_COMPLETED {
+			game.SetPlaces(make([]int64, len(game.GetTeams())))
+			game.SetFinal()
+		}
+	}
+	round.SetFinal()
+	next, err := tourney.NextRound()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paired := map[string]bool{}
+	for _, game := range next.GetGames() {
+		for _, team := range game.GetTeams() {
+			if paired[team.GetName()] {
+				t.Errorf("%s was paired twice", team.GetName())
+			}
+			paired[team.GetName()] = true
+		}
+	}
+	if len(paired) != 5 {
+		t.Errorf("Paired %d players, expected all 5", len(paired))
+	}
+}
@@ -13,13 +13,27 @@ import (
 )
 
 func GenerateTournamentHTML(t models.Tournament) ([]byte, error) {
-	bracketNames := t.GetBracketOrder()
-	rounds := t.GetAllRounds()
-
 	var out []byte
 
 	out = append(out, []byte("<h1>"+t.GetName()+"</h1>")...)
 
+	for _, bracket := range tournamentBrackets(t) {
+		h, err := bracket.FancyHTML()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, h...)
+
+	}
+
+	return out, nil
+}
+
+// tournamentBrackets splits the games of a tournament into its brackets, in the order of GetBracketOrder, with a column of games for every round
+func tournamentBrackets(t models.Tournament) []Bracket {
+	bracketNames := t.GetBracketOrder()
+	rounds := t.GetAllRounds()
+
 	brackets := map[string]Bracket{}
 
 	for i, round := range rounds {
@@ -47,16 +61,73 @@ func GenerateTournamentHTML(t models.Tournament) ([]byte, error) {
 
 	//fmt.Println(brackets)
 
+	ordered := make([]Bracket, 0, len(bracketNames))
 	for _, b := range bracketNames {
-		h, err := brackets[b].FancyHTML()
-		if err != nil {
-			return nil, err
+		ordered = append(ordered, brackets[b])
+	}
+	return ordered
+}
+
+// gamePosition locates a game within the brackets of a tournament, by the index of the bracket, round and game
+type gamePosition struct {
+	Bracket, Round, Game int
+}
+
+// gameLink connects a game to the next game one of its winners plays
+type gameLink struct {
+	Team models.Team
+	To   gamePosition
+}
+
+// winnerLinks finds the next game that each winner of a game plays, which may be in another bracket.
+// Only formats with an overall winner advance teams through their brackets, so other formats have no links
+func winnerLinks(brackets []Bracket) map[gamePosition][]gameLink {
+	links := map[gamePosition][]gameLink{}
+	if len(brackets) == 0 || !brackets[0].FinalWinner {
+		return links
+	}
+	rounds := 0
+	for _, b := range brackets {
+		if len(b.Rounds) > rounds {
+			rounds = len(b.Rounds)
 		}
-		out = append(out, h...)
+	}
 
+	// next returns the first game from a round onward that a team plays in
+	next := func(round int, team models.Team) (gamePosition, bool) {
+		for r := round; r < rounds; r++ {
+			for bi, b := range brackets {
+				if r >= len(b.Rounds) {
+					continue
+				}
+				for gi, game := range b.Rounds[r] {
+					for _, t := range game.GetTeams() {
+						if !models.IsByeTeam(t) && t.GetName() == team.GetName() {
+							return gamePosition{bi, r, gi}, true
+						}
+					}
+				}
+			}
+		}
+		return gamePosition{}, false
 	}
 
-	return out, nil
+	for bi, b := range brackets {
+		for r, round := range b.Rounds {
+			for gi, game := range round {
+				from := gamePosition{bi, r, gi}
+				for _, team := range game.GetTeams() {
+					if !IsWinner(team, game, b.Advance) {
+						continue
+					}
+					if to, ok := next(r+1, team); ok {
+						links[from] = append(links[from], gameLink{team, to})
+					}
+				}
+			}
+		}
+	}
+	return links
 }
 
 type CompetitionOverError error
@@ -141,7 +212,7 @@ const bracketHTML = `
 		{{ range $k, $team := $game.GetTeams -}}
 			{{ if $team }}
 				{{ if $showGame }}
-				<li class="game{{if eq $k 0}} game-top{{end}}{{if last $k $game.GetTeams }} game-bottom{{end}}{{if winner $game $team }} winner{{end}}">{{if $team.Metadata}}<img src="{{printf "%s" $team.Metadata}}">{{else}}<span></span>{{end}}{{$team.Name}} <span>{{$notBye := not $team.IsBye}}{{if and $scored $notBye }}{{score $game $team}}{{end}}</span></li>
+				<li class="game{{if eq $k 0}} game-top{{end}}{{if last $k $game.GetTeams }} game-bottom{{end}}{{if winner $game $team }} winner{{end}}">{{if $team.Metadata}}<img src="{{printf "%s" $team.Metadata}}">{{else}}<span></span>{{end}}{{$team.Name}}{{with result $game $team}} <abbr class="result">{{.}}</abbr>{{end}} <span>{{$notBye := not $team.IsBye}}{{if and $scored $notBye }}{{score $game $team}}{{end}}</span>{{if and $scored $notBye }}{{range periods $game $team}}<span class="period{{if .Overtime}} overtime{{end}}" title="{{.Label}}">{{.Score}}</span>{{end}}{{end}}</li>
 				{{ else }}
 				<li class="game{{if eq $k 0}} game-top{{end}}{{if last $k $game.GetTeams }} game-bottom{{end}}">  <span></span></li>
 				{{ end }}
@@ -162,6 +233,32 @@ type Bracket struct {
 	FinalWinner bool
 }
 
+// winner returns the team that won the bracket, nil if it isn't over or doesn't have a single winner
+func (b Bracket) winner() models.Team {
+	if len(b.Rounds) == 0 {
+		return nil
+	}
+	lastRound := b.Rounds[len(b.Rounds)-1]
+	if len(lastRound) != 1 { // Games still to be played against each other
+		return nil
+	}
+
+	lastMatch := lastRound[0]
+	if lastMatch.GetStatus() != models.Status_COMPLETED {
+		return nil
+	}
+	if !b.FinalWinner {
+		return nil
+	}
+	for _, team := range lastMatch.GetTeams() {
+		if IsWinner(team, lastMatch, b.Advance) {
+			return team
+		}
+	}
+
+	return nil
+}
+
 func (b Bracket) FancyHTML() ([]byte, error) {
 
 	funcMap := template.FuncMap{
@@ -182,31 +279,9 @@ func (b Bracket) FancyHTML() ([]byte, error) {
 			return int(game.GetScores()[located])
 
 		},
-		"lastWinner": func() models.Team {
-			if len(b.Rounds) == 0 {
-				return nil
-			}
-			lastRound := b.Rounds[len(b.Rounds)-1]
-			if len(lastRound) == 0 {
-				return nil
-			}
-
-			lastMatch := lastRound[0]
-			if lastMatch.GetStatus() != models.Status_COMPLETED {
-				return nil
-			}
-			if !b.FinalWinner {
-				return nil
-			}
-			for _, team := range lastMatch.GetTeams() {
-				if IsWinner(team, lastMatch, b.Advance) {
-					return team
-				}
-			}
-
-			return nil
-
-		},
+		"periods":    teamPeriods,
+		"result":     resultMarker,
+		"lastWinner": b.winner,
 		"showGame": func(g models.Game) bool {
 			return true
 		},
@@ -312,11 +387,12 @@ const bigGameHTML = `
 {{ $completed := complete $game}}
 <div class="mdl-grid bigGame">
 {{range $i, $team := $game.GetTeams }}
-	{{ $place := index $game.Places $i}}
+	{{ $place := index $game.GetPlaces $i}}
   <div class="mdl-cell mdl-cell--{{width}}-col mdl-cell--{{tabletWidth}}-col-tablet mdl-cell--{{mobileWidth}}-col-phone {{backgroundColor $i}}"> <div class="team">
-    {{ if $team.Metadata }}<span><img src="{{$team.Metadata}}" /></span>{{end}}
-	<h3 class="{{if winner $game $team }}winner{{end}}{{if displayPlace $place }} mdl-badge {{ if not $completed }} placed {{end}} {{end}}" {{ if displayPlace $place }}data-badge="{{ displayPlace $place }}"{{end}}>{{$team.Name}}</h3>
-	{{if $scored}}<h3 {{if winner $game $team }}class="winner"{{end}}>{{index $game.Scores $i}}</h3>{{end}}
+    {{ with $team.GetMetadata }}<span><img src="{{printf "%s" .}}" /></span>{{end}}
+	<h3 class="{{if winner $game $team }}winner{{end}}{{if displayPlace $place }} mdl-badge {{ if not $completed }} placed {{end}} {{end}}" {{ if displayPlace $place }}data-badge="{{ displayPlace $place }}"{{end}}>{{$team.GetName}}{{with result $game $team}} <abbr class="result">{{.}}</abbr>{{end}}</h3>
+	{{if $scored}}<h3 {{if winner $game $team }}class="winner"{{end}}>{{index $game.GetScores $i}}</h3>{{end}}
+	{{if $scored}}{{with periods $game $team}}<div class="periods">{{range .}}<span class="period{{if .Overtime}} overtime{{end}}"><b>{{.Label}}</b> {{.Score}}</span>{{end}}</div>{{end}}{{end}}
   </div></div>
 {{ end }}
 </div>
@@ -367,6 +443,8 @@ func BigGameToHTML(g models.Game, scored bool) ([]byte, error) {
 		"complete": func(game models.Game) bool {
 			return game.GetStatus() == models.Status_COMPLETED
 		},
+		"periods": teamPeriods,
+		"result":  resultMarker,
 		"width": func() int {
 			return 12 / len(g.GetTeams())
 		},
@@ -392,7 +470,7 @@ func BigGameToHTML(g models.Game, scored bool) ([]byte, error) {
 			}
 			return ""
 		},
-		"displayPlace": func(place int32) int32 {
+		"displayPlace": func(place int64) int64 {
 			if g.GetStatus() == models.Status_COMPLETED {
 				place++
 			}
@@ -412,6 +490,54 @@ func BigGameToHTML(g models.Game, scored bool) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type periodScore struct {
+	Label    string
+	Score    int64
+	Overtime bool
+}
+
+// teamPeriods returns the period by period breakdown of a team's score in a game
+func teamPeriods(game models.Game, team models.Team) []periodScore {
+	if models.IsByeTeam(team) {
+		return nil
+	}
+	index := -1
+	for i, t := range game.GetTeams() {
+		if team.Equals(t) {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+	periods := game.GetPeriods()
+	var scores []periodScore
+	for i, score := range periods.TeamScores(index) {
+		scores = append(scores, periodScore{periods.Label(i), score, periods.IsOvertime(i)})
+	}
+	return scores
+}
+
+// resultMarker returns a short marker for games a team didn't finish by playing, like forfeits, disqualifications and walkovers
+func resultMarker(game models.Game, team models.Team) string {
+	if models.IsByeTeam(team) {
+		return ""
+	}
+	result, _ := game.GetTeamResult(team)
+	switch result {
+	case models.Result_FORFEIT:
+		return "FF"
+	case models.Result_DISQUALIFIED:
+		return "DQ"
+	case models.Result_WALKOVER:
+		if len(game.GetTeams()) > 1 { // A team alone in a game just had a bye
+			return "W/O"
+		}
+	}
+	return ""
+}
+
 func RandomizeTeams(teams []models.Team) {
 	places := rand.Perm(len(teams))
 	tmp := make([]models.Team, len(teams))